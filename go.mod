@@ -19,6 +19,7 @@ require (
 	github.com/keybase/go-crypto v0.0.0-20200123153347-de78d2cb44f4
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/opentelekomcloud/gophertelekomcloud v0.9.4-0.20250402100114-d7c0a7a9131e
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	github.com/unknwon/com v1.0.1
 	golang.org/x/crypto v0.31.0
 	golang.org/x/sync v0.10.0
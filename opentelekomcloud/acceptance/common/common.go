@@ -151,6 +151,12 @@ func TestAccPreCheckApigw(t *testing.T) {
 	}
 }
 
+func TestAccPreCheckHssPolicyGroup(t *testing.T) {
+	if env.OS_HSS_POLICY_GROUP_ID == "" {
+		t.Skip("OS_HSS_POLICY_GROUP_ID must be set for HSS policy group acceptance tests")
+	}
+}
+
 func TestAccPreCheckServiceAvailability(t *testing.T, service string, regions []string) diag.Diagnostics {
 	t.Logf("Service: %s, Region %s", service, env.OS_REGION_NAME)
 	config := TestAccProvider.Meta().(*cfg.Config)
@@ -0,0 +1,157 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/csms"
+)
+
+const resourceSecretName = "opentelekomcloud_csms_secret_v1.secret"
+
+func TestAccCsmsSecretV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-secret-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCsmsSecretV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCsmsSecretV1Basic(name, "first-value", "secret created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSecretName, "name", name),
+					resource.TestCheckResourceAttr(resourceSecretName, "secret_string", "first-value"),
+					resource.TestCheckResourceAttr(resourceSecretName, "state", "ENABLED"),
+					resource.TestCheckResourceAttrSet(resourceSecretName, "latest_version_id"),
+				),
+			},
+			{
+				Config: testAccCsmsSecretV1Basic(name, "second-value", "secret updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSecretName, "secret_string", "second-value"),
+					resource.TestCheckResourceAttr(resourceSecretName, "description", "secret updated by terraform"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_csms_secret_version_v1.previous",
+						"secret_string", "first-value"),
+				),
+			},
+			{
+				ResourceName:            resourceSecretName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_string"},
+			},
+		},
+	})
+}
+
+func TestAccCsmsSecretVersionV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-secret-%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_csms_secret_version_v1.version"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCsmsSecretV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCsmsSecretVersionV1Basic(name, `"SYSCURRENT"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "version_stages.#", "1"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_csms_secret_version_v1.latest",
+						"secret_string", "versioned-value"),
+				),
+			},
+			{
+				Config: testAccCsmsSecretVersionV1Basic(name, `"SYSCURRENT", "tf-pinned"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "version_stages.#", "2"),
+				),
+			},
+			{
+				Config:      testAccCsmsSecretVersionV1Basic(name, `"tf-pinned"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`SYSCURRENT stage can't be removed from the version`),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCsmsSecretV1Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.CsmsV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CSMSv1 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_csms_secret_v1" {
+			continue
+		}
+		_, err := csms.GetSecret(client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("CSMS secret still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCsmsSecretV1Basic(name, value, description string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_csms_secret_v1" "secret" {
+  name          = "%s"
+  secret_string = "%s"
+  description   = "%s"
+}
+
+data "opentelekomcloud_csms_secret_version_v1" "previous" {
+  secret_name   = opentelekomcloud_csms_secret_v1.secret.name
+  version_stage = "SYSPREVIOUS"
+
+  depends_on = [opentelekomcloud_csms_secret_v1.secret]
+}
+`, name, value, description)
+}
+
+func testAccCsmsSecretVersionV1Basic(name, stages string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_csms_secret_v1" "secret" {
+  name          = "%s"
+  secret_string = "initial-value"
+
+  lifecycle {
+    ignore_changes = [secret_string]
+  }
+}
+
+resource "opentelekomcloud_csms_secret_version_v1" "version" {
+  secret_name    = opentelekomcloud_csms_secret_v1.secret.name
+  secret_string  = "versioned-value"
+  version_stages = [%s]
+}
+
+data "opentelekomcloud_csms_secret_version_v1" "latest" {
+  secret_name = opentelekomcloud_csms_secret_v1.secret.name
+
+  depends_on = [opentelekomcloud_csms_secret_version_v1.version]
+}
+`, name, stages)
+}
@@ -0,0 +1,61 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceIndexTemplateName = "opentelekomcloud_css_index_template_v1.template"
+
+func TestAccCssIndexTemplateV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckCssEndpoint(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy: testAccCheckCssObjectDestroy("opentelekomcloud_css_index_template_v1", func(rs *terraform.ResourceState) string {
+			return "_template/" + rs.Primary.ID
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssIndexTemplateV1Basic(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceIndexTemplateName, "name", name),
+					resource.TestCheckResourceAttrSet(resourceIndexTemplateName, "body"),
+				),
+			},
+			{
+				Config: testAccCssIndexTemplateV1Basic(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceIndexTemplateName, "name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCssIndexTemplateV1Basic(name string, shards int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_css_index_template_v1" "template" {
+  %s
+  name = "%s"
+  body = jsonencode({
+    index_patterns = ["%s-*"]
+    settings = {
+      number_of_shards = %d
+    }
+    mappings = {
+      properties = {
+        timestamp = { type = "date" }
+      }
+    }
+  })
+}
+`, testAccCssConnection(), name, name, shards)
+}
@@ -0,0 +1,67 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceLifecyclePolicyName = "opentelekomcloud_css_lifecycle_policy_v1.policy"
+
+func TestAccCssLifecyclePolicyV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckCssEndpoint(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssLifecyclePolicyV1Basic(name, "7d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceLifecyclePolicyName, "name", name),
+					resource.TestCheckResourceAttr(resourceLifecyclePolicyName, "type", "ism"),
+				),
+			},
+			{
+				Config: testAccCssLifecyclePolicyV1Basic(name, "14d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceLifecyclePolicyName, "name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCssLifecyclePolicyV1Basic(name, age string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_css_lifecycle_policy_v1" "policy" {
+  %s
+  name = "%s"
+  body = jsonencode({
+    description   = "delete old indices"
+    default_state = "hot"
+    states = [
+      {
+        name    = "hot"
+        actions = []
+        transitions = [
+          {
+            state_name = "delete"
+            conditions = { min_index_age = "%s" }
+          }
+        ]
+      },
+      {
+        name        = "delete"
+        actions     = [{ delete = {} }]
+        transitions = []
+      }
+    ]
+  })
+}
+`, testAccCssConnection(), name, age)
+}
@@ -0,0 +1,69 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccCssRoleV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf_acc_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckCssCluster(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy: testAccCheckCssObjectDestroy("opentelekomcloud_css_role_v1", func(rs *terraform.ResourceState) string {
+			return "_opendistro/_security/api/roles/" + rs.Primary.ID
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssRoleV1Basic(name, "read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCssRoleName, "name", name),
+					resource.TestCheckResourceAttrSet(resourceCssRoleName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceCssRoleName, "body"),
+				),
+			},
+			{
+				Config: testAccCssRoleV1Basic(name, "crud"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCssRoleName, "name", name),
+				),
+			},
+			{
+				ResourceName:      resourceCssRoleName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/%s", os.Getenv("OS_CSS_CLUSTER_ID"), name), nil
+				},
+				// imported body contains the defaults added by the cluster
+				ImportStateVerifyIgnore: []string{"insecure", "body"},
+			},
+		},
+	})
+}
+
+func testAccCssRoleV1Basic(name, action string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_css_role_v1" "role" {
+  %[1]s
+  name = "%[2]s"
+  body = jsonencode({
+    cluster_permissions = ["cluster_composite_ops_ro"]
+    index_permissions = [
+      {
+        index_patterns  = ["logs-*"]
+        allowed_actions = ["%[3]s"]
+      }
+    ]
+  })
+}
+`, testAccCssClusterConnection(), name, action)
+}
@@ -0,0 +1,71 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const (
+	resourceCssRoleName = "opentelekomcloud_css_role_v1.role"
+	resourceCssUserName = "opentelekomcloud_css_user_v1.user"
+)
+
+func TestAccCssRoleUserV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf_acc_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckCssEndpoint(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssRoleUserV1Basic(name, "read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCssRoleName, "name", name),
+					resource.TestCheckResourceAttr(resourceCssUserName, "name", name),
+					resource.TestCheckResourceAttr(resourceCssUserName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceCssUserName, "attributes.team", "qa"),
+				),
+			},
+			{
+				Config: testAccCssRoleUserV1Basic(name, "crud"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCssRoleName, "name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCssRoleUserV1Basic(name, action string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_css_role_v1" "role" {
+  %[1]s
+  name = "%[2]s"
+  body = jsonencode({
+    cluster_permissions = ["cluster_composite_ops_ro"]
+    index_permissions = [
+      {
+        index_patterns  = ["logs-*"]
+        allowed_actions = ["%[3]s"]
+      }
+    ]
+  })
+}
+
+resource "opentelekomcloud_css_user_v1" "user" {
+  %[1]s
+  name     = "%[2]s"
+  password = "Test@1234567!"
+  roles    = [opentelekomcloud_css_role_v1.role.name]
+
+  attributes = {
+    team = "qa"
+  }
+}
+`, testAccCssConnection(), name, action)
+}
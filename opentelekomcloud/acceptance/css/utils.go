@@ -1,10 +1,17 @@
 package acceptance
 
 import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/flavors"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
@@ -55,3 +62,71 @@ func sharedFlavorQuotas(t *testing.T, nodeCount int64, volumeSize int64) quotas.
 	qts.X(nodeCount)
 	return qts
 }
+
+// testAccPreCheckCssEndpoint requires a cluster reachable over its HTTP API,
+// a local OpenSearch container with the security plugin can be used instead of a CSS cluster.
+func testAccPreCheckCssEndpoint(t *testing.T) {
+	common.TestAccPreCheck(t)
+	if os.Getenv("OS_CSS_ENDPOINT") == "" {
+		t.Skip("OS_CSS_ENDPOINT env var is not set")
+	}
+}
+
+// testAccPreCheckCssCluster requires an existing CSS cluster with `enable_authority`
+func testAccPreCheckCssCluster(t *testing.T) {
+	common.TestAccPreCheck(t)
+	if os.Getenv("OS_CSS_CLUSTER_ID") == "" || os.Getenv("OS_CSS_ADMIN_PASS") == "" {
+		t.Skip("OS_CSS_CLUSTER_ID and OS_CSS_ADMIN_PASS env vars are not set")
+	}
+}
+
+func testAccCssClusterConnection() string {
+	return fmt.Sprintf(`
+  cluster_id = "%s"
+  insecure   = true
+`, os.Getenv("OS_CSS_CLUSTER_ID"))
+}
+
+func testAccCssConnection() string {
+	return fmt.Sprintf(`
+  endpoint   = "%s"
+  admin_pass = "%s"
+  insecure   = true
+`, os.Getenv("OS_CSS_ENDPOINT"), os.Getenv("OS_CSS_ADMIN_PASS"))
+}
+
+// testAccCheckCssObjectDestroy checks that the objects of the resource type are not found on the cluster
+func testAccCheckCssObjectDestroy(resourceType string, path func(rs *terraform.ResourceState) string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // nolint:gosec
+		}}
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			endpoint := rs.Primary.Attributes["endpoint"]
+			if endpoint == "" {
+				endpoint = os.Getenv("OS_CSS_ENDPOINT")
+			}
+			endpoint = strings.Split(endpoint, ",")[0]
+			if !strings.Contains(endpoint, "://") {
+				endpoint = "https://" + endpoint
+			}
+			req, err := http.NewRequest(http.MethodGet, endpoint+"/"+path(rs), nil)
+			if err != nil {
+				return err
+			}
+			req.SetBasicAuth("admin", os.Getenv("OS_CSS_ADMIN_PASS"))
+			resp, err := client.Do(req)
+			if err != nil {
+				return err
+			}
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
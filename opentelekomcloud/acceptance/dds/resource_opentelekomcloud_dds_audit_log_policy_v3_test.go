@@ -0,0 +1,83 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dds"
+)
+
+const resourceDdsAuditLogPolicyName = "opentelekomcloud_dds_audit_log_policy_v3.policy"
+
+func getAuditLogPolicyFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.DdsV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+	policy, err := dds.GetAuditLogPolicy(client, state.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+	if policy.KeepDays == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return policy, nil
+}
+
+func TestAccDdsAuditLogPolicyV3_basic(t *testing.T) {
+	var policy dds.AuditLogPolicy
+
+	rc := common.InitResourceCheck(
+		resourceDdsAuditLogPolicyName,
+		&policy,
+		getAuditLogPolicyFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDdsAuditLogPolicyV3Basic(7),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceDdsAuditLogPolicyName, "keep_days", "7"),
+					resource.TestCheckResourceAttr(resourceDdsAuditLogPolicyName, "audit_types.#", "2"),
+				),
+			},
+			{
+				Config: testDdsAuditLogPolicyV3Basic(14),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceDdsAuditLogPolicyName, "keep_days", "14"),
+				),
+			},
+			{
+				ResourceName:      resourceDdsAuditLogPolicyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDdsAuditLogPolicyV3Basic(keepDays int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dds_audit_log_policy_v3" "policy" {
+  instance_id = opentelekomcloud_dds_instance_v3.instance.id
+  keep_days   = %d
+  audit_types = ["auth", "insert"]
+}
+`, TestAccDDSInstanceV3ConfigSingle, keepDays)
+}
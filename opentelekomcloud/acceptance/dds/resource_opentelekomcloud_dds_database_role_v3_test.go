@@ -0,0 +1,88 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dds"
+)
+
+const resourceDdsDatabaseRoleName = "opentelekomcloud_dds_database_role_v3.role"
+
+func getDatabaseRoleFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.DdsV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+	roles, err := dds.ListDatabaseRoles(client, state.Primary.Attributes["instance_id"], dds.ListDatabaseRolesOpts{
+		Name:   state.Primary.Attributes["name"],
+		DbName: state.Primary.Attributes["db_name"],
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return roles[0], nil
+}
+
+func TestAccDdsDatabaseRoleV3_basic(t *testing.T) {
+	var role dds.DatabaseRole
+
+	name := fmt.Sprintf("dds_acc_role_%s", acctest.RandString(5))
+	rc := common.InitResourceCheck(
+		resourceDdsDatabaseRoleName,
+		&role,
+		getDatabaseRoleFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDdsDatabaseRoleV3Basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceDdsDatabaseRoleName, "name", name),
+					resource.TestCheckResourceAttr(resourceDdsDatabaseRoleName, "db_name", "admin"),
+					resource.TestCheckResourceAttr(resourceDdsDatabaseRoleName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceDdsDatabaseRoleName, "roles.0.name", "readWrite"),
+					resource.TestCheckResourceAttrSet(resourceDdsDatabaseRoleName, "inherited_privileges.#"),
+				),
+			},
+			{
+				ResourceName:      resourceDdsDatabaseRoleName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDdsDatabaseRoleV3Basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dds_database_role_v3" "role" {
+  instance_id = opentelekomcloud_dds_instance_v3.instance.id
+  name        = "%s"
+
+  roles {
+    name    = "readWrite"
+    db_name = "admin"
+  }
+}
+`, TestAccDDSInstanceV3ConfigSingle, name)
+}
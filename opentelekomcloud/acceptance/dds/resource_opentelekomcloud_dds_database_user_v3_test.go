@@ -0,0 +1,109 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dds"
+)
+
+const resourceDdsDatabaseUserName = "opentelekomcloud_dds_database_user_v3.user"
+
+func getDatabaseUserFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.DdsV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+	users, err := dds.ListDatabaseUsers(client, state.Primary.Attributes["instance_id"], dds.ListDatabaseUsersOpts{
+		Name:   state.Primary.Attributes["name"],
+		DbName: state.Primary.Attributes["db_name"],
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return users[0], nil
+}
+
+func TestAccDdsDatabaseUserV3_basic(t *testing.T) {
+	var user dds.DatabaseUser
+
+	name := fmt.Sprintf("dds_acc_user_%s", acctest.RandString(5))
+	rc := common.InitResourceCheck(
+		resourceDdsDatabaseUserName,
+		&user,
+		getDatabaseUserFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDdsDatabaseUserV3Basic(name, "Test@Passw0rd"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceDdsDatabaseUserName, "name", name),
+					resource.TestCheckResourceAttr(resourceDdsDatabaseUserName, "db_name", "admin"),
+					resource.TestCheckResourceAttr(resourceDdsDatabaseUserName, "roles.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceDdsDatabaseUserName, "roles.0.name",
+						resourceDdsDatabaseRoleName, "name"),
+				),
+			},
+			{
+				Config: testDdsDatabaseUserV3Basic(name, "Test@Passw0rdUpdated"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceDdsDatabaseUserName, "password", "Test@Passw0rdUpdated"),
+				),
+			},
+			{
+				ResourceName:      resourceDdsDatabaseUserName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func testDdsDatabaseUserV3Basic(name, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dds_database_role_v3" "role" {
+  instance_id = opentelekomcloud_dds_instance_v3.instance.id
+  name        = "%[2]s_role"
+
+  roles {
+    name    = "read"
+    db_name = "admin"
+  }
+}
+
+resource "opentelekomcloud_dds_database_user_v3" "user" {
+  instance_id = opentelekomcloud_dds_instance_v3.instance.id
+  name        = "%[2]s"
+  password    = "%[3]s"
+
+  roles {
+    name    = opentelekomcloud_dds_database_role_v3.role.name
+    db_name = opentelekomcloud_dds_database_role_v3.role.db_name
+  }
+}
+`, TestAccDDSInstanceV3ConfigSingle, name, password)
+}
@@ -0,0 +1,138 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/configurations"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	resourceDdsParameterTemplateName          = "opentelekomcloud_dds_parameter_template_v3.template"
+	resourceDdsParameterTemplateAssociateName = "opentelekomcloud_dds_parameter_template_associate_v3.associate"
+)
+
+func getParameterTemplateFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.DdsV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+	return configurations.Get(client, state.Primary.ID)
+}
+
+func TestAccDdsParameterTemplateV3_basic(t *testing.T) {
+	var template configurations.Response
+
+	name := fmt.Sprintf("dds_acc_template_%s", acctest.RandString(5))
+	rc := common.InitResourceCheck(
+		resourceDdsParameterTemplateName,
+		&template,
+		getParameterTemplateFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDdsParameterTemplateV3Basic(name, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceDdsParameterTemplateName, "name", name),
+					resource.TestCheckResourceAttr(resourceDdsParameterTemplateName, "node_type", "single"),
+					resource.TestCheckResourceAttr(resourceDdsParameterTemplateName, "parameter_values.operationProfiling.slowOpThresholdMs", "100"),
+					resource.TestCheckResourceAttrSet(resourceDdsParameterTemplateName, "parameters.#"),
+				),
+			},
+			{
+				Config: testDdsParameterTemplateV3Basic(name+"_updated", "200"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceDdsParameterTemplateName, "name", name+"_updated"),
+					resource.TestCheckResourceAttr(resourceDdsParameterTemplateName, "parameter_values.operationProfiling.slowOpThresholdMs", "200"),
+				),
+			},
+			{
+				ResourceName:      resourceDdsParameterTemplateName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"parameter_values",
+				},
+			},
+		},
+	})
+}
+
+func TestAccDdsParameterTemplateAssociateV3_basic(t *testing.T) {
+	name := fmt.Sprintf("dds_acc_template_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDDSV3InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDdsParameterTemplateAssociateV3Basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceDdsParameterTemplateAssociateName, "template_id",
+						resourceDdsParameterTemplateName, "id"),
+					resource.TestCheckResourceAttrPair(resourceDdsParameterTemplateAssociateName, "entity_id",
+						resourceInstanceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceDdsParameterTemplateAssociateName, "restart_required"),
+				),
+			},
+			{
+				ResourceName:      resourceDdsParameterTemplateAssociateName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"restart_required",
+					"pending_restart_parameters",
+				},
+			},
+		},
+	})
+}
+
+func testDdsParameterTemplateV3Basic(name, threshold string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dds_parameter_template_v3" "template" {
+  name        = "%s"
+  description = "acceptance test template"
+  node_type   = "single"
+
+  datastore {
+    version = "3.4"
+  }
+
+  parameter_values = {
+    "operationProfiling.slowOpThresholdMs" = "%s"
+  }
+}
+`, name, threshold)
+}
+
+func testDdsParameterTemplateAssociateV3Basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+%s
+
+resource "opentelekomcloud_dds_parameter_template_associate_v3" "associate" {
+  instance_id = opentelekomcloud_dds_instance_v3.instance.id
+  template_id = opentelekomcloud_dds_parameter_template_v3.template.id
+}
+`, TestAccDDSInstanceV3ConfigSingle, testDdsParameterTemplateV3Basic(name, "100"))
+}
@@ -0,0 +1,119 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dns"
+)
+
+const (
+	resourceEndpointName = "opentelekomcloud_dns_resolver_endpoint_v2.endpoint"
+	resourceRuleName     = "opentelekomcloud_dns_resolver_rule_v2.rule"
+)
+
+func getDNSEndpointResourceFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.DnsV21Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud DNS v2.1 client: %s", err)
+	}
+	return dns.GetEndpoint(client, state.Primary.ID)
+}
+
+func getDNSResolverRuleResourceFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.DnsV21Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud DNS v2.1 client: %s", err)
+	}
+	return dns.GetResolverRule(client, state.Primary.ID)
+}
+
+func TestAccDNSV2ResolverRule_basic(t *testing.T) {
+	var (
+		endpoint dns.Endpoint
+		rule     dns.ResolverRule
+		name     = fmt.Sprintf("dns-resolver-%s", acctest.RandString(5))
+	)
+
+	rcEndpoint := common.InitResourceCheck(resourceEndpointName, &endpoint, getDNSEndpointResourceFunc)
+	rcRule := common.InitResourceCheck(resourceRuleName, &rule, getDNSResolverRuleResourceFunc)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			rcRule.CheckResourceDestroy(),
+			rcEndpoint.CheckResourceDestroy(),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2ResolverRuleBasic(name, "10.10.0.53"),
+				Check: resource.ComposeTestCheckFunc(
+					rcEndpoint.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceEndpointName, "direction", "outbound"),
+					resource.TestCheckResourceAttr(resourceEndpointName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceEndpointName, "vpc_id"),
+					rcRule.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceRuleName, "name", name),
+					resource.TestCheckResourceAttr(resourceRuleName, "ip_addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceRuleName, "router.#", "1"),
+				),
+			},
+			{
+				Config: testAccDNSV2ResolverRuleBasic(name+"-upd", "10.10.1.53"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEndpointName, "name", name+"-upd"),
+					resource.TestCheckResourceAttr(resourceRuleName, "name", name+"-upd"),
+					resource.TestCheckTypeSetElemAttr(resourceRuleName, "ip_addresses.*", "10.10.1.53"),
+				),
+			},
+			{
+				ResourceName:      resourceEndpointName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceRuleName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDNSV2ResolverRuleBasic(name, targetIP string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "opentelekomcloud_dns_resolver_endpoint_v2" "endpoint" {
+  name      = "%[2]s"
+  direction = "outbound"
+
+  ip_addresses {
+    subnet_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.subnet_id
+  }
+  ip_addresses {
+    subnet_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.subnet_id
+  }
+}
+
+resource "opentelekomcloud_dns_resolver_rule_v2" "rule" {
+  name         = "%[2]s"
+  domain_name  = "onprem.example.com."
+  endpoint_id  = opentelekomcloud_dns_resolver_endpoint_v2.endpoint.id
+  ip_addresses = ["%[3]s"]
+
+  router {
+    router_id     = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+    router_region = "%[4]s"
+  }
+}
+`, common.DataSourceSubnet, name, targetIP, env.OS_REGION_NAME)
+}
@@ -0,0 +1,100 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zones"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceZoneAssociationName = "opentelekomcloud_dns_zone_association_v2.association"
+
+func TestAccDNSV2ZoneAssociation_basic(t *testing.T) {
+	zoneName := randomZoneName()
+	vpcName := fmt.Sprintf("dns-assoc-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDNSV2ZoneAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2ZoneAssociationBasic(zoneName, vpcName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceZoneAssociationName, "zone_id",
+						"opentelekomcloud_dns_zone_v2.zone_1", "id"),
+					resource.TestCheckResourceAttrPair(resourceZoneAssociationName, "router_id",
+						"opentelekomcloud_vpc_v1.vpc_2", "id"),
+					resource.TestCheckResourceAttr(resourceZoneAssociationName, "router_region", env.OS_REGION_NAME),
+					resource.TestCheckResourceAttr(resourceZoneAssociationName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceZoneAssociationName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2ZoneAssociationDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.DnsV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dns_zone_association_v2" {
+			continue
+		}
+
+		zone, err := zones.Get(client, rs.Primary.Attributes["zone_id"]).Extract()
+		if err != nil {
+			// zone is already deleted
+			continue
+		}
+		for _, router := range zone.Routers {
+			if router.RouterID == rs.Primary.Attributes["router_id"] {
+				return fmt.Errorf("zone association still exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccDNSV2ZoneAssociationBasic(zoneName, vpcName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_v1" "vpc_2" {
+  name = "%s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%s"
+  email = "email1@example.com"
+  type  = "private"
+
+  router {
+    router_id     = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+    router_region = "%s"
+  }
+}
+
+resource "opentelekomcloud_dns_zone_association_v2" "association" {
+  zone_id   = opentelekomcloud_dns_zone_v2.zone_1.id
+  router_id = opentelekomcloud_vpc_v1.vpc_2.id
+}
+`, common.DataSourceSubnet, vpcName, zoneName, env.OS_REGION_NAME)
+}
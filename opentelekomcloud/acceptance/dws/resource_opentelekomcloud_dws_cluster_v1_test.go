@@ -127,7 +127,6 @@ resource "opentelekomcloud_dws_cluster_v1" "cluster_1" {
 `, common.DataSourceSecGroupDefault, common.DataSourceSubnet, clusterName, env.OS_AVAILABILITY_ZONE)
 }
 
-// extend not stable, skip this for now
 func testAccDwsV1ClusterUpdated(clusterName string) string {
 	return fmt.Sprintf(`
 %s
@@ -137,7 +136,7 @@ func testAccDwsV1ClusterUpdated(clusterName string) string {
 resource "opentelekomcloud_dws_cluster_v1" "cluster_1" {
   name              = "%s"
   user_name         = "dbadmin"
-  user_pwd          = "#dbadmin12345"
+  user_pwd          = "#dbadmin54321"
   node_type         = "dws.m3.xlarge"
   number_of_node    = 6
   network_id        = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id
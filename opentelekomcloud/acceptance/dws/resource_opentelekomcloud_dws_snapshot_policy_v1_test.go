@@ -0,0 +1,62 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceSnapshotPolicyName = "opentelekomcloud_dws_snapshot_policy_v1.policy"
+
+func TestAccDwsSnapshotPolicyV1_basic(t *testing.T) {
+	clusterName := fmt.Sprintf("dws_cluster_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDwsV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDwsV1SnapshotPolicyBasic(clusterName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSnapshotPolicyName, "keep_day", "3"),
+					resource.TestCheckResourceAttr(resourceSnapshotPolicyName, "strategy.#", "1"),
+					resource.TestCheckResourceAttr(resourceSnapshotPolicyName, "strategy.0.type", "full"),
+					resource.TestCheckResourceAttrSet(resourceSnapshotPolicyName, "strategy.0.id"),
+				),
+			},
+			{
+				Config: testAccDwsV1SnapshotPolicyBasic(clusterName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSnapshotPolicyName, "keep_day", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceSnapshotPolicyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDwsV1SnapshotPolicyBasic(clusterName string, keepDay int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dws_snapshot_policy_v1" "policy" {
+  cluster_id = opentelekomcloud_dws_cluster_v1.cluster_1.id
+  keep_day   = %d
+
+  strategy {
+    name = "daily_full"
+    cron = "0 0 2 * * ?"
+    type = "full"
+  }
+}
+`, testAccDwsV1ClusterBasic(clusterName), keepDay)
+}
@@ -0,0 +1,100 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dws/v1/snapshot"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	resourceSnapshotName = "opentelekomcloud_dws_snapshot_v1.snapshot"
+	resourceRestoreName  = "opentelekomcloud_dws_cluster_restore_v1.restored"
+)
+
+func getDwsSnapshotFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.DwsV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DWSv1 client: %w", err)
+	}
+	return snapshot.ListSnapshotDetails(client, state.Primary.ID)
+}
+
+func TestAccDwsSnapshotV1_basic(t *testing.T) {
+	var snap snapshot.SnapshotDetail
+	clusterName := fmt.Sprintf("dws_cluster_%s", acctest.RandString(5))
+	rc := common.InitResourceCheck(resourceSnapshotName, &snap, getDwsSnapshotFunc)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDwsV1SnapshotBasic(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "name", clusterName+"_snap"),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "type", "MANUAL"),
+				),
+			},
+			{
+				ResourceName:      resourceSnapshotName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDwsClusterRestoreV1_basic(t *testing.T) {
+	clusterName := fmt.Sprintf("dws_cluster_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDwsV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDwsV1ClusterRestoreBasic(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRestoreName, "name", clusterName+"_restored"),
+					resource.TestCheckResourceAttr(resourceRestoreName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceRestoreName, "number_of_node", "3"),
+					resource.TestCheckResourceAttrPair(resourceRestoreName, "node_type", resourceInstanceName, "node_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDwsV1SnapshotBasic(clusterName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dws_snapshot_v1" "snapshot" {
+  cluster_id  = opentelekomcloud_dws_cluster_v1.cluster_1.id
+  name        = "%s_snap"
+  description = "acceptance test"
+}
+`, testAccDwsV1ClusterBasic(clusterName), clusterName)
+}
+
+func testAccDwsV1ClusterRestoreBasic(clusterName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dws_cluster_restore_v1" "restored" {
+  snapshot_id = opentelekomcloud_dws_snapshot_v1.snapshot.id
+  name        = "%s_restored"
+}
+`, testAccDwsV1SnapshotBasic(clusterName), clusterName)
+}
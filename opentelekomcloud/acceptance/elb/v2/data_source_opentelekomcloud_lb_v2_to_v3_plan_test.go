@@ -0,0 +1,98 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataPlanName = "data.opentelekomcloud_lb_v2_to_v3_plan.this"
+
+func TestAccLbV2ToV3PlanDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			qts := quotas.MultipleQuotas{
+				{Q: quotas.LoadBalancer, Count: 1},
+				{Q: quotas.LbListener, Count: 1},
+				{Q: quotas.LbPool, Count: 1},
+			}
+			quotas.BookMany(t, qts)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2ToV3PlanDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataPlanName, "resources.#", "5"),
+					resource.TestCheckResourceAttr(dataPlanName, "resources.0.type", "opentelekomcloud_lb_loadbalancer_v3"),
+					resource.TestCheckResourceAttr(dataPlanName, "resources.0.name", "loadbalancer_1"),
+					resource.TestCheckResourceAttr(dataPlanName, "resources.1.type", "opentelekomcloud_lb_listener_v3"),
+					resource.TestCheckResourceAttr(dataPlanName, "resources.1.arguments.protocol", `"HTTP"`),
+					resource.TestCheckResourceAttr(dataPlanName, "resources.2.type", "opentelekomcloud_lb_pool_v3"),
+					resource.TestCheckResourceAttr(dataPlanName, "resources.2.arguments.listener_id",
+						"opentelekomcloud_lb_listener_v3.listener_1.id"),
+					resource.TestCheckResourceAttr(dataPlanName, "resources.3.type", "opentelekomcloud_lb_monitor_v3"),
+					resource.TestCheckResourceAttr(dataPlanName, "resources.4.type", "opentelekomcloud_lb_member_v3"),
+					resource.TestCheckResourceAttr(dataPlanName, "resources.4.arguments.weight", "10"),
+					resource.TestCheckResourceAttrSet(dataPlanName, "hcl"),
+					resource.TestCheckResourceAttr(dataPlanName, "unsupported.0.attribute", "vip_address"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLbV2ToV3PlanDataSourceBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.subnet_id
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+}
+
+resource "opentelekomcloud_lb_monitor_v2" "monitor_1" {
+  pool_id     = opentelekomcloud_lb_pool_v2.pool_1.id
+  type        = "HTTP"
+  delay       = 20
+  timeout     = 10
+  max_retries = 5
+  url_path    = "/"
+}
+
+resource "opentelekomcloud_lb_member_v2" "member_1" {
+  address       = "192.168.0.11"
+  protocol_port = 8080
+  pool_id       = opentelekomcloud_lb_pool_v2.pool_1.id
+  subnet_id     = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.subnet_id
+  weight        = 10
+}
+
+data "opentelekomcloud_lb_v2_to_v3_plan" "this" {
+  loadbalancer_id    = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+  availability_zones = ["%s"]
+
+  depends_on = [
+    opentelekomcloud_lb_monitor_v2.monitor_1,
+    opentelekomcloud_lb_member_v2.member_1,
+  ]
+}
+`, common.DataSourceSubnet, env.OS_AVAILABILITY_ZONE)
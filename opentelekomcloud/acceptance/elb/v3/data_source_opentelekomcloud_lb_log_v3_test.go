@@ -0,0 +1,41 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const dataLogName = "data.opentelekomcloud_lb_log_v3.log"
+
+func TestDataSourceLBLogV3_basic(t *testing.T) {
+	qts := []*quotas.ExpectedQuota{{Q: quotas.LoadBalancer, Count: 1}}
+	t.Parallel()
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBLogV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataLogName, "id", resourceLogName, "id"),
+					resource.TestCheckResourceAttrPair(dataLogName, "log_group_id", resourceLogName, "log_group_id"),
+					resource.TestCheckResourceAttrPair(dataLogName, "log_topic_id", resourceLogName, "log_topic_id"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBLogV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_log_v3" "log" {
+  loadbalancer_id = opentelekomcloud_lb_log_v3.log.loadbalancer_id
+}
+`, testAccLBV3LogConfigBasic)
@@ -0,0 +1,46 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const dataMembersName = "data.opentelekomcloud_lb_members_v3.members"
+
+func TestDataSourceLBMembersV3_basic(t *testing.T) {
+	qts := []*quotas.ExpectedQuota{
+		{Q: quotas.LbPool, Count: 1},
+		{Q: quotas.LoadBalancer, Count: 1},
+	}
+	t.Parallel()
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBMembersV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataMembersName, "members.#", "1"),
+					resource.TestCheckResourceAttrPair(dataMembersName, "members.0.id", resourceMemberName, "id"),
+					resource.TestCheckResourceAttr(dataMembersName, "members.0.protocol_port", "8080"),
+					resource.TestCheckResourceAttrSet(dataMembersName, "members.0.operating_status"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBMembersV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_members_v3" "members" {
+  pool_id = opentelekomcloud_lb_member_v3.member.pool_id
+  name    = opentelekomcloud_lb_member_v3.member.name
+}
+`, testLBMemberV3Basic)
@@ -0,0 +1,45 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const dataMonitorName = "data.opentelekomcloud_lb_monitor_v3.monitor"
+
+func TestDataSourceLBMonitorV3_basic(t *testing.T) {
+	qts := []*quotas.ExpectedQuota{
+		{Q: quotas.LbPool, Count: 1},
+		{Q: quotas.LoadBalancer, Count: 1},
+	}
+	t.Parallel()
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBMonitorV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataMonitorName, "id", resourceMonitorName, "id"),
+					resource.TestCheckResourceAttr(dataMonitorName, "type", "HTTP"),
+					resource.TestCheckResourceAttr(dataMonitorName, "monitor_port", "8080"),
+					resource.TestCheckResourceAttr(dataMonitorName, "max_retries", "5"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBMonitorV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_monitor_v3" "monitor" {
+  pool_id = opentelekomcloud_lb_monitor_v3.monitor.pool_id
+}
+`, testResourceMonitorBasic)
@@ -0,0 +1,45 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const dataPoliciesName = "data.opentelekomcloud_lb_policies_v3.policies"
+
+func TestDataSourceLBPoliciesV3_basic(t *testing.T) {
+	qts := []*quotas.ExpectedQuota{
+		{Q: quotas.LbPool, Count: 1},
+		{Q: quotas.LoadBalancer, Count: 1},
+	}
+	t.Parallel()
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBPoliciesV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataPoliciesName, "policies.#", "1"),
+					resource.TestCheckResourceAttrPair(dataPoliciesName, "policies.0.id", resourcePolicyName, "id"),
+					resource.TestCheckResourceAttr(dataPoliciesName, "policies.0.action", "REDIRECT_TO_POOL"),
+					resource.TestCheckResourceAttr(dataPoliciesName, "policies.0.position", "37"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBPoliciesV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_policies_v3" "policies" {
+  listener_id = opentelekomcloud_lb_policy_v3.this.listener_id
+}
+`, testAccLBV3PolicyConfigBasic)
@@ -0,0 +1,46 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const dataPoolName = "data.opentelekomcloud_lb_pool_v3.pool"
+
+func TestDataSourceLBPoolV3_basic(t *testing.T) {
+	qts := []*quotas.ExpectedQuota{
+		{Q: quotas.LbPool, Count: 1},
+		{Q: quotas.LoadBalancer, Count: 1},
+	}
+	t.Parallel()
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBPoolV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataPoolName, "id", resourcePoolName, "id"),
+					resource.TestCheckResourceAttr(dataPoolName, "name", "pool_1"),
+					resource.TestCheckResourceAttr(dataPoolName, "protocol", "QUIC"),
+					resource.TestCheckResourceAttr(dataPoolName, "session_persistence.0.type", "SOURCE_IP"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBPoolV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_pool_v3" "pool" {
+  name            = opentelekomcloud_lb_pool_v3.pool.name
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.lb.id
+}
+`, testLBPoolV3Updated)
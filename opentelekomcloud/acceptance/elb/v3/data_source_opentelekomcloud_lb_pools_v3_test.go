@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const dataPoolsName = "data.opentelekomcloud_lb_pools_v3.pools"
+
+func TestDataSourceLBPoolsV3_basic(t *testing.T) {
+	qts := []*quotas.ExpectedQuota{
+		{Q: quotas.LbPool, Count: 1},
+		{Q: quotas.LoadBalancer, Count: 1},
+	}
+	t.Parallel()
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBPoolsV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataPoolsName, "pools.#", "1"),
+					resource.TestCheckResourceAttrPair(dataPoolsName, "pools.0.id", resourcePoolName, "id"),
+					resource.TestCheckResourceAttrPair(dataPoolsName, "pools.0.loadbalancer_id", "opentelekomcloud_lb_loadbalancer_v3.lb", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBPoolsV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_pools_v3" "pools" {
+  loadbalancer_id = opentelekomcloud_lb_pool_v3.pool.loadbalancer_id
+}
+`, testLBPoolV3Basic)
@@ -99,6 +99,36 @@ func TestAccLBV3LoadBalancer_eipAssignment(t *testing.T) {
 	})
 }
 
+func TestAccLBV3LoadBalancer_ipv6(t *testing.T) {
+	var lb loadbalancers.LoadBalancer
+
+	qts := []*quotas.ExpectedQuota{
+		{Q: quotas.LoadBalancer, Count: 1},
+		{Q: quotas.Subnet, Count: 1},
+		{Q: quotas.SharedBandwidth, Count: 1},
+	}
+	t.Parallel()
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV3LoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3LoadBalancerConfigIPv6,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3LoadBalancerExists(resourceLBName, &lb),
+					resource.TestCheckResourceAttrPair(resourceLBName, "ipv6_network_id", "opentelekomcloud_vpc_subnet_v1.dual_stack", "id"),
+					resource.TestCheckResourceAttrPair(resourceLBName, "ipv6_bandwidth_id", resourceBWName, "id"),
+					resource.TestCheckResourceAttrSet(resourceLBName, "ipv6_vip_address"),
+					resource.TestCheckResourceAttrSet(resourceLBName, "ipv6_vip_port_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLBV3LoadBalancer_import(t *testing.T) {
 	qts := lbQuotas()
 	t.Parallel()
@@ -308,3 +338,30 @@ resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
 resource "opentelekomcloud_networking_floatingip_v2" "fip_1" {}
 
 `, common.DataSourceSubnet, env.OS_AVAILABILITY_ZONE)
+
+var testAccLBV3LoadBalancerConfigIPv6 = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_subnet_v1" "dual_stack" {
+  name        = "subnet_lb_ipv6"
+  cidr        = "192.168.220.0/24"
+  gateway_ip  = "192.168.220.1"
+  vpc_id      = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+  ipv6_enable = true
+}
+
+resource "opentelekomcloud_vpc_bandwidth_v2" "bw" {
+  name = "lb_ipv6_band"
+  size = 10
+}
+
+resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
+  name              = "loadbalancer_1"
+  subnet_id         = opentelekomcloud_vpc_subnet_v1.dual_stack.subnet_id
+  network_ids       = [opentelekomcloud_vpc_subnet_v1.dual_stack.network_id]
+  ipv6_network_id   = opentelekomcloud_vpc_subnet_v1.dual_stack.id
+  ipv6_bandwidth_id = opentelekomcloud_vpc_bandwidth_v2.bw.id
+
+  availability_zones = ["%s"]
+}
+`, common.DataSourceSubnet, env.OS_AVAILABILITY_ZONE)
@@ -0,0 +1,128 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	elbv3 "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/elb/v3"
+)
+
+const resourceLogName = "opentelekomcloud_lb_log_v3.log"
+
+func TestAccLBV3Log_basic(t *testing.T) {
+	qts := []*quotas.ExpectedQuota{{Q: quotas.LoadBalancer, Count: 1}}
+	t.Parallel()
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV3LogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3LogConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceLogName, "loadbalancer_id", resourceLBName, "id"),
+					resource.TestCheckResourceAttrPair(resourceLogName, "log_group_id", "opentelekomcloud_lts_group_v2.group", "id"),
+					resource.TestCheckResourceAttrPair(resourceLogName, "log_topic_id", "opentelekomcloud_lts_stream_v2.stream_1", "id"),
+					resource.TestCheckResourceAttrSet(resourceLogName, "project_id"),
+				),
+			},
+			{
+				Config: testAccLBV3LogConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceLogName, "log_topic_id", "opentelekomcloud_lts_stream_v2.stream_2", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceLogName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLBV3LogDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.ElbV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf(elbv3.ErrCreateClient, err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_log_v3" {
+			continue
+		}
+
+		_, err := elbv3.GetLogTank(client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("LoadBalancer log still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccLBV3LogBase = `
+resource "opentelekomcloud_lts_group_v2" "group" {
+  group_name  = "lb_log_group"
+  ttl_in_days = 7
+}
+
+resource "opentelekomcloud_lts_stream_v2" "stream_1" {
+  group_id    = opentelekomcloud_lts_group_v2.group.id
+  stream_name = "lb_log_stream_1"
+}
+
+resource "opentelekomcloud_lts_stream_v2" "stream_2" {
+  group_id    = opentelekomcloud_lts_group_v2.group.id
+  stream_name = "lb_log_stream_2"
+}
+`
+
+var testAccLBV3LogConfigBasic = fmt.Sprintf(`
+%s
+
+%s
+
+resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
+  name        = "loadbalancer_log"
+  router_id   = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+  network_ids = [data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id]
+
+  availability_zones = ["%s"]
+}
+
+resource "opentelekomcloud_lb_log_v3" "log" {
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  log_group_id    = opentelekomcloud_lts_group_v2.group.id
+  log_topic_id    = opentelekomcloud_lts_stream_v2.stream_1.id
+}
+`, common.DataSourceSubnet, testAccLBV3LogBase, env.OS_AVAILABILITY_ZONE)
+
+var testAccLBV3LogConfigUpdate = fmt.Sprintf(`
+%s
+
+%s
+
+resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
+  name        = "loadbalancer_log"
+  router_id   = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+  network_ids = [data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id]
+
+  availability_zones = ["%s"]
+}
+
+resource "opentelekomcloud_lb_log_v3" "log" {
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  log_group_id    = opentelekomcloud_lts_group_v2.group.id
+  log_topic_id    = opentelekomcloud_lts_stream_v2.stream_2.id
+}
+`, common.DataSourceSubnet, testAccLBV3LogBase, env.OS_AVAILABILITY_ZONE)
@@ -7,27 +7,28 @@ import (
 )
 
 var (
-	OsFlavorID           = flavorID()
-	OsImageName          = imageName()
-	OsExtNetworkName     = extNetworkName()
-	OS_REGION_NAME       string
-	OS_ACCESS_KEY        = os.Getenv("OS_ACCESS_KEY")
-	OS_SECRET_KEY        = os.Getenv("OS_SECRET_KEY")
-	OS_AVAILABILITY_ZONE = os.Getenv("OS_AVAILABILITY_ZONE")
-	OsSubnetName         = os.Getenv("OS_SUBNET_NAME")
-	OS_KEYPAIR_NAME      = os.Getenv("OS_KEYPAIR_NAME")
-	OS_KMS_ID            = os.Getenv("OS_KMS_ID")
-	OsKmsName            = os.Getenv("OS_KMS_NAME")
-	OS_BMS_FLAVOR_NAME   = os.Getenv("OS_BMS_FLAVOR_NAME")
-	OS_TO_TENANT_ID      = os.Getenv("OS_TO_TENANT_ID")
-	OS_TENANT_NAME       = GetTenantName()
-	OS_PROJECT_ID        = os.Getenv("OS_PROJECT_ID")
-	OS_DEST_REGION       = os.Getenv("OS_DEST_REGION")
-	OS_DEST_PROJECT_ID   = os.Getenv("OS_DEST_PROJECT_ID")
-	OS_DC_HOSTING_ID     = os.Getenv("OS_DC_HOSTING_ID")
-	OS_DDM_ID            = os.Getenv("OS_DDM_ID")
-	OS_RDS_ID            = os.Getenv("OS_RDS_ID")
-	OS_APIGW_GATEWAY_ID  = os.Getenv("OS_APIGW_GATEWAY_ID")
+	OsFlavorID             = flavorID()
+	OsImageName            = imageName()
+	OsExtNetworkName       = extNetworkName()
+	OS_REGION_NAME         string
+	OS_ACCESS_KEY          = os.Getenv("OS_ACCESS_KEY")
+	OS_SECRET_KEY          = os.Getenv("OS_SECRET_KEY")
+	OS_AVAILABILITY_ZONE   = os.Getenv("OS_AVAILABILITY_ZONE")
+	OsSubnetName           = os.Getenv("OS_SUBNET_NAME")
+	OS_KEYPAIR_NAME        = os.Getenv("OS_KEYPAIR_NAME")
+	OS_KMS_ID              = os.Getenv("OS_KMS_ID")
+	OsKmsName              = os.Getenv("OS_KMS_NAME")
+	OS_BMS_FLAVOR_NAME     = os.Getenv("OS_BMS_FLAVOR_NAME")
+	OS_TO_TENANT_ID        = os.Getenv("OS_TO_TENANT_ID")
+	OS_TENANT_NAME         = GetTenantName()
+	OS_PROJECT_ID          = os.Getenv("OS_PROJECT_ID")
+	OS_DEST_REGION         = os.Getenv("OS_DEST_REGION")
+	OS_DEST_PROJECT_ID     = os.Getenv("OS_DEST_PROJECT_ID")
+	OS_DC_HOSTING_ID       = os.Getenv("OS_DC_HOSTING_ID")
+	OS_DDM_ID              = os.Getenv("OS_DDM_ID")
+	OS_RDS_ID              = os.Getenv("OS_RDS_ID")
+	OS_APIGW_GATEWAY_ID    = os.Getenv("OS_APIGW_GATEWAY_ID")
+	OS_HSS_POLICY_GROUP_ID = os.Getenv("OS_HSS_POLICY_GROUP_ID")
 )
 
 func flavorID() string {
@@ -0,0 +1,84 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceErV3_basic(t *testing.T) {
+	var (
+		name          = fmt.Sprintf("er-acc-ds-%s", acctest.RandString(5))
+		bgpAsNum      = acctest.RandIntRange(64512, 65534)
+		instancesName = "data.opentelekomcloud_er_instances_v3.test"
+		tablesName    = "data.opentelekomcloud_er_route_tables_v3.test"
+		attachName    = "data.opentelekomcloud_er_attachments_v3.test"
+	)
+
+	dcInstances := common.InitDataSourceCheck(instancesName)
+	dcTables := common.InitDataSourceCheck(tablesName)
+	dcAttachments := common.InitDataSourceCheck(attachName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceErV3_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					dcInstances.CheckResourceExists(),
+					resource.TestCheckResourceAttr(instancesName, "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(instancesName, "instances.0.id",
+						"opentelekomcloud_er_instance_v3.test", "id"),
+					resource.TestCheckResourceAttr(instancesName, "instances.0.asn", fmt.Sprint(bgpAsNum)),
+					dcTables.CheckResourceExists(),
+					resource.TestCheckResourceAttr(tablesName, "route_tables.#", "1"),
+					resource.TestCheckResourceAttrPair(tablesName, "route_tables.0.id",
+						"opentelekomcloud_er_route_table_v3.test", "id"),
+					dcAttachments.CheckResourceExists(),
+					resource.TestCheckResourceAttr(attachName, "attachments.#", "1"),
+					resource.TestCheckResourceAttr(attachName, "attachments.0.type", "vpc"),
+					resource.TestCheckResourceAttrPair(attachName, "attachments.0.resource_id",
+						"opentelekomcloud_vpc_v1.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceErV3_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "opentelekomcloud_er_route_table_v3" "test" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  name        = "%[2]s"
+}
+
+resource "opentelekomcloud_er_vpc_attachment_v3" "test" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  vpc_id      = opentelekomcloud_vpc_v1.test.id
+  subnet_id   = opentelekomcloud_vpc_subnet_v1.test.id
+  name        = "%[2]s"
+}
+
+data "opentelekomcloud_er_instances_v3" "test" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+}
+
+data "opentelekomcloud_er_route_tables_v3" "test" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  name        = opentelekomcloud_er_route_table_v3.test.name
+}
+
+data "opentelekomcloud_er_attachments_v3" "test" {
+  depends_on = [opentelekomcloud_er_vpc_attachment_v3.test]
+
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  type        = "vpc"
+}
+`, testVpcAttachment_base(name, bgpAsNum), name)
+}
@@ -0,0 +1,94 @@
+package er
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/er"
+)
+
+func getShareResourceFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.RamV1Client()
+	if err != nil {
+		return nil, fmt.Errorf("error creating RAM client: %s", err)
+	}
+
+	return er.GetResourceShare(client, state.Primary.ID)
+}
+
+func TestAccErShareV3_basic(t *testing.T) {
+	accountID := os.Getenv("OS_ACCOUNT_ID_2")
+	if accountID == "" {
+		t.Skip("OS_ACCOUNT_ID_2 is empty, but test requires")
+	}
+
+	var (
+		obj      er.ResourceShare
+		rName    = "opentelekomcloud_er_share_v3.test"
+		name     = fmt.Sprintf("er-acc-share-%s", acctest.RandString(5))
+		bgpAsNum = acctest.RandIntRange(64512, 65534)
+	)
+
+	rc := common.InitResourceCheck(
+		rName,
+		&obj,
+		getShareResourceFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testErShare_basic(name, "Create by acc test", accountID, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "opentelekomcloud_er_instance_v3.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Create by acc test"),
+					resource.TestCheckResourceAttr(rName, "principals.#", "1"),
+					resource.TestCheckResourceAttrSet(rName, "resource_urn"),
+					resource.TestCheckResourceAttrSet(rName, "owning_account_id"),
+				),
+			},
+			{
+				Config: testErShare_basic(name+"-updated", "", accountID, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name+"-updated"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testErShare_basic(name, description, accountID string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_er_instance_v3" "test" {
+  availability_zones = ["eu-de-01", "eu-de-02"]
+
+  name = "%[1]s"
+  asn  = %[4]d
+}
+
+resource "opentelekomcloud_er_share_v3" "test" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  name        = "%[1]s"
+  description = "%[2]s"
+  principals  = ["%[3]s"]
+}
+`, name, description, accountID, bgpAsNum)
+}
@@ -0,0 +1,100 @@
+package er
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/er"
+)
+
+func getVpnAttachmentResourceFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.ErV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Enterprise Router client: %s", err)
+	}
+
+	return er.GetVpnAttachment(client, state.Primary.Attributes["instance_id"], state.Primary.ID)
+}
+
+func TestAccVpnAttachmentV3_basic(t *testing.T) {
+	vpnConnectionID := os.Getenv("OS_VPN_CONNECTION_ID")
+	if vpnConnectionID == "" {
+		t.Skip("OS_VPN_CONNECTION_ID is empty, but test requires")
+	}
+
+	var (
+		obj        er.VpnAttachment
+		rName      = "opentelekomcloud_er_vpn_attachment_v3.test"
+		name       = fmt.Sprintf("er-acc-vpn-%s", acctest.RandString(5))
+		updateName = fmt.Sprintf("er-acc-vpn-%s", acctest.RandString(5))
+		bgpAsNum   = acctest.RandIntRange(64512, 65534)
+	)
+
+	rc := common.InitResourceCheck(
+		rName,
+		&obj,
+		getVpnAttachmentResourceFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testVpnAttachment_basic(name, "Create by acc test", vpnConnectionID, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "vpn_connection_id", vpnConnectionID),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Create by acc test"),
+					resource.TestCheckResourceAttr(rName, "status", "available"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testVpnAttachment_basic(updateName, "", vpnConnectionID, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVpcAttachmentImportStateFunc(rName),
+			},
+		},
+	})
+}
+
+func testVpnAttachment_basic(name, description, vpnConnectionID string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_er_instance_v3" "test" {
+  availability_zones = ["eu-de-01", "eu-de-02"]
+
+  name = "%[1]s"
+  asn  = %[4]d
+}
+
+resource "opentelekomcloud_er_vpn_attachment_v3" "test" {
+  instance_id       = opentelekomcloud_er_instance_v3.test.id
+  vpn_connection_id = "%[3]s"
+  name              = "%[1]s"
+  description       = "%[2]s"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, name, description, vpnConnectionID, bgpAsNum)
+}
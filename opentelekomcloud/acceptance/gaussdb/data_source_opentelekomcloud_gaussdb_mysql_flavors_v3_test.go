@@ -0,0 +1,33 @@
+package gaussdb
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceGaussDBFlavorsName = "data.opentelekomcloud_gaussdb_mysql_flavors_v3.flavors"
+
+func TestAccGaussDBFlavorsV3DataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussDBFlavorsV3DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceGaussDBFlavorsName, "flavors.#"),
+					resource.TestCheckResourceAttr(dataSourceGaussDBFlavorsName, "flavors.0.vcpus", "4"),
+				),
+			},
+		},
+	})
+}
+
+const testAccGaussDBFlavorsV3DataSourceBasic = `
+data "opentelekomcloud_gaussdb_mysql_flavors_v3" "flavors" {
+  availability_zone_mode = "multi"
+  vcpus                  = "4"
+}
+`
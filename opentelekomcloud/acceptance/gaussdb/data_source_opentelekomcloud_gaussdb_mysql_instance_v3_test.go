@@ -0,0 +1,43 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceGaussDBInstanceName = "data.opentelekomcloud_gaussdb_mysql_instance_v3.instance"
+
+func TestAccGaussDBInstanceV3DataSource_basic(t *testing.T) {
+	name := "tf_gaussdb_ds" + acctest.RandString(3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussDBInstanceV3DataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceGaussDBInstanceName, "id", instanceV3ResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceGaussDBInstanceName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceGaussDBInstanceName, "datastore.0.engine", "gaussdb-mysql"),
+					resource.TestCheckResourceAttr(dataSourceGaussDBInstanceName, "port", "3306"),
+					resource.TestCheckResourceAttrSet(dataSourceGaussDBInstanceName, "nodes.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGaussDBInstanceV3DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_gaussdb_mysql_instance_v3" "instance" {
+  name = opentelekomcloud_gaussdb_mysql_instance_v3.instance.name
+}
+`, testAccGaussdbMySqlInstanceV3Basic(name))
+}
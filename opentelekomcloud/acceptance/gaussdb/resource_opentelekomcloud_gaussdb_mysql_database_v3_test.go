@@ -0,0 +1,84 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const (
+	resourceGaussDBDatabaseName = "opentelekomcloud_gaussdb_mysql_database_v3.database"
+	resourceGaussDBAccountName  = "opentelekomcloud_gaussdb_mysql_account_v3.account"
+)
+
+func TestAccGaussDBDatabaseAccountV3_basic(t *testing.T) {
+	name := "tf_gaussdb_db" + acctest.RandString(3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussDBDatabaseAccountV3Basic(name, "database", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceGaussDBDatabaseName, "name", name),
+					resource.TestCheckResourceAttr(resourceGaussDBDatabaseName, "character_set", "utf8mb4"),
+					resource.TestCheckResourceAttr(resourceGaussDBDatabaseName, "description", "database"),
+					resource.TestCheckResourceAttr(resourceGaussDBAccountName, "name", name),
+					resource.TestCheckResourceAttr(resourceGaussDBAccountName, "host", "%"),
+					resource.TestCheckResourceAttr(resourceGaussDBAccountName, "databases.#", "1"),
+					resource.TestCheckResourceAttr(resourceGaussDBAccountName, "databases.0.readonly", "true"),
+				),
+			},
+			{
+				Config: testAccGaussDBDatabaseAccountV3Basic(name, "updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceGaussDBDatabaseName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceGaussDBAccountName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceGaussDBAccountName, "databases.0.readonly", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceGaussDBDatabaseName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceGaussDBAccountName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func testAccGaussDBDatabaseAccountV3Basic(name, description string, readonly bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_gaussdb_mysql_database_v3" "database" {
+  instance_id   = opentelekomcloud_gaussdb_mysql_instance_v3.instance.id
+  name          = "%[2]s"
+  character_set = "utf8mb4"
+  description   = "%[3]s"
+}
+
+resource "opentelekomcloud_gaussdb_mysql_account_v3" "account" {
+  instance_id = opentelekomcloud_gaussdb_mysql_instance_v3.instance.id
+  name        = "%[2]s"
+  password    = "Test123!@#"
+  description = "%[3]s"
+
+  databases {
+    name     = opentelekomcloud_gaussdb_mysql_database_v3.database.name
+    readonly = %[4]t
+  }
+}
+`, testAccGaussdbMySqlInstanceV3Basic(name), name, description, readonly)
+}
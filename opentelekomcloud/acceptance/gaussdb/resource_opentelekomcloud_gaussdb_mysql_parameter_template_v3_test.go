@@ -0,0 +1,87 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/gaussdb"
+)
+
+const resourceGaussDBParameterTemplateName = "opentelekomcloud_gaussdb_mysql_parameter_template_v3.template"
+
+func getGaussDBParameterTemplateFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.GaussDBV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud GaussDB client: %s", err)
+	}
+	return gaussdb.GetParameterTemplate(client, state.Primary.ID)
+}
+
+func TestAccGaussDBParameterTemplateV3_basic(t *testing.T) {
+	var template gaussdb.ParameterTemplate
+
+	name := fmt.Sprintf("gaussdb_acc_template_%s", acctest.RandString(5))
+	rc := common.InitResourceCheck(
+		resourceGaussDBParameterTemplateName,
+		&template,
+		getGaussDBParameterTemplateFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussDBParameterTemplateV3Basic(name, "60"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceGaussDBParameterTemplateName, "name", name),
+					resource.TestCheckResourceAttr(resourceGaussDBParameterTemplateName, "datastore.0.engine", "gaussdb-mysql"),
+					resource.TestCheckResourceAttr(resourceGaussDBParameterTemplateName, "parameter_values.connect_timeout", "60"),
+					resource.TestCheckResourceAttrSet(resourceGaussDBParameterTemplateName, "parameters.#"),
+				),
+			},
+			{
+				Config: testAccGaussDBParameterTemplateV3Basic(name+"_updated", "120"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceGaussDBParameterTemplateName, "name", name+"_updated"),
+					resource.TestCheckResourceAttr(resourceGaussDBParameterTemplateName, "parameter_values.connect_timeout", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceGaussDBParameterTemplateName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"parameter_values",
+				},
+			},
+		},
+	})
+}
+
+func testAccGaussDBParameterTemplateV3Basic(name, timeout string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_gaussdb_mysql_parameter_template_v3" "template" {
+  name        = "%s"
+  description = "acceptance test"
+
+  datastore {
+    engine  = "gaussdb-mysql"
+    version = "8.0"
+  }
+
+  parameter_values = {
+    connect_timeout = "%s"
+  }
+}
+`, name, timeout)
+}
@@ -0,0 +1,70 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceGaussDBProxyName = "opentelekomcloud_gaussdb_mysql_proxy_v3.proxy"
+
+func TestAccGaussDBProxyV3_basic(t *testing.T) {
+	name := "tf_gaussdb_proxy" + acctest.RandString(3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussDBProxyV3Basic(name, 2, 50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceGaussDBProxyName, "node_num", "2"),
+					resource.TestCheckResourceAttr(resourceGaussDBProxyName, "proxy_mode", "readwrite"),
+					resource.TestCheckResourceAttr(resourceGaussDBProxyName, "master_node_weight", "50"),
+					resource.TestCheckResourceAttrSet(resourceGaussDBProxyName, "address"),
+					resource.TestCheckResourceAttrSet(resourceGaussDBProxyName, "port"),
+				),
+			},
+			{
+				Config: testAccGaussDBProxyV3Basic(name, 3, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceGaussDBProxyName, "node_num", "3"),
+					resource.TestCheckResourceAttr(resourceGaussDBProxyName, "master_node_weight", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceGaussDBProxyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccGaussDBProxyV3ImportStateFunc(resourceGaussDBProxyName),
+			},
+		},
+	})
+}
+
+func testAccGaussDBProxyV3ImportStateFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", name)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccGaussDBProxyV3Basic(name string, nodeNum, weight int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_gaussdb_mysql_proxy_v3" "proxy" {
+  instance_id        = opentelekomcloud_gaussdb_mysql_instance_v3.instance.id
+  flavor             = "gaussdb.proxy.large.x86.2"
+  node_num           = %d
+  master_node_weight = %d
+}
+`, testAccGaussdbMySqlInstanceV3Basic(name), nodeNum, weight)
+}
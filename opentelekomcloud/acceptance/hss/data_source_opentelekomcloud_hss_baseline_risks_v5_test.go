@@ -0,0 +1,37 @@
+package hss
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceBaselineRisks_basic(t *testing.T) {
+	dataSource := "data.opentelekomcloud_hss_baseline_risks_v5.risks"
+	dc := common.InitDataSourceCheck(dataSource)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceBaselineRisks_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(dataSource, "risks.#"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceBaselineRisks_basic() string {
+	return `
+data "opentelekomcloud_hss_baseline_risks_v5" "risks" {
+  standard = "hw_standard"
+}
+`
+}
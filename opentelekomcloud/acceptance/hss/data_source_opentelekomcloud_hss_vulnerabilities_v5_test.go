@@ -0,0 +1,38 @@
+package hss
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceVulnerabilities_basic(t *testing.T) {
+	dataSource := "data.opentelekomcloud_hss_vulnerabilities_v5.vulnerabilities"
+	dc := common.InitDataSourceCheck(dataSource)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceVulnerabilities_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(dataSource, "vulnerabilities.#"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceVulnerabilities_basic() string {
+	return `
+data "opentelekomcloud_hss_vulnerabilities_v5" "vulnerabilities" {
+  type          = "linux_vul"
+  handle_status = "unhandled"
+}
+`
+}
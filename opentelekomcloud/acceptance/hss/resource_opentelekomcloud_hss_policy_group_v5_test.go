@@ -0,0 +1,122 @@
+package hss
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/hss"
+)
+
+func getPolicyGroupFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HssV5Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating HSS v5 client: %s", err)
+	}
+	return hss.QueryPolicyGroupById(client, "", state.Primary.ID)
+}
+
+func TestAccPolicyGroup_basic(t *testing.T) {
+	var (
+		pg *hss.PolicyGroup
+
+		name  = fmt.Sprintf("hss-acc-api%s", acctest.RandString(5))
+		rName = "opentelekomcloud_hss_policy_group_v5.group"
+	)
+
+	rc := common.InitResourceCheck(
+		rName,
+		&pg,
+		getPolicyGroupFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckHssPolicyGroup(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyGroup_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "created by acceptance test"),
+					resource.TestCheckResourceAttr(rName, "deletable", "true"),
+					resource.TestCheckResourceAttr(rName, "default_group", "false"),
+					resource.TestCheckResourceAttrSet(rName, "support_os"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"source_group_id",
+				},
+			},
+		},
+	})
+}
+
+func TestAccPolicyGroupBinding_basic(t *testing.T) {
+	var (
+		name  = fmt.Sprintf("hss-acc-api%s", acctest.RandString(5))
+		rName = "opentelekomcloud_hss_policy_group_binding_v5.binding"
+	)
+
+	// Hosts can't be unbound from policy groups, they are moved to the default group instead.
+	// lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckHssPolicyGroup(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyGroupBinding_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "policy_group_id",
+						"opentelekomcloud_hss_policy_group_v5.group", "id"),
+					resource.TestCheckResourceAttr(rName, "host_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPolicyGroup_basic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_hss_policy_group_v5" "group" {
+  name            = "%s"
+  source_group_id = "%s"
+  description     = "created by acceptance test"
+}
+`, name, env.OS_HSS_POLICY_GROUP_ID)
+}
+
+func testAccPolicyGroupBinding_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+%[2]s
+
+resource "opentelekomcloud_hss_policy_group_binding_v5" "binding" {
+  policy_group_id = opentelekomcloud_hss_policy_group_v5.group.id
+  host_ids        = [opentelekomcloud_hss_host_protection_v5.protection.host_id]
+}
+`, testAccHostProtection_basic(name), testAccPolicyGroup_basic(name))
+}
@@ -0,0 +1,73 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataPolicyDocumentName = "data.opentelekomcloud_identity_policy_document.policy"
+
+func TestAccIdentityPolicyDocumentDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityPolicyDocumentDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataPolicyDocumentName, "json", testAccIdentityPolicyDocumentExpected),
+				),
+			},
+		},
+	})
+}
+
+const testAccIdentityPolicyDocumentDataSourceBasic = `
+data "opentelekomcloud_identity_policy_document" "policy" {
+  statement {
+    actions = ["ecs:*:list*", "ecs:*:get*", "ecs:*:list*"]
+
+    condition {
+      operator = "IpAddress"
+      key      = "g:SourceIp"
+      values   = ["10.0.0.0/8"]
+    }
+  }
+
+  statement {
+    effect  = "Deny"
+    actions = ["ecs:servers:delete"]
+  }
+}
+`
+
+const testAccIdentityPolicyDocumentExpected = `{
+  "Version": "1.1",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ecs:*:get*",
+        "ecs:*:list*"
+      ],
+      "Condition": {
+        "IpAddress": {
+          "g:SourceIp": [
+            "10.0.0.0/8"
+          ]
+        }
+      }
+    },
+    {
+      "Effect": "Deny",
+      "Action": [
+        "ecs:servers:delete"
+      ]
+    }
+  ]
+}`
@@ -0,0 +1,77 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataPolicySimulationName = "data.opentelekomcloud_identity_policy_simulation_v3.sim"
+
+func TestAccIdentityV3PolicySimulationDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3PolicySimulationDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataPolicySimulationName, "results.#", "3"),
+					resource.TestCheckResourceAttr(dataPolicySimulationName, "results.0.decision", "allow"),
+					resource.TestCheckResourceAttr(dataPolicySimulationName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataPolicySimulationName, "results.1.decision", "explicit_deny"),
+					resource.TestCheckResourceAttr(dataPolicySimulationName, "results.1.policy_id", "policy_documents.0"),
+					resource.TestCheckResourceAttr(dataPolicySimulationName, "results.1.statement_index", "1"),
+					resource.TestCheckResourceAttr(dataPolicySimulationName, "results.2.decision", "allow"),
+					resource.TestCheckResourceAttrPair(dataPolicySimulationName, "results.2.policy_id",
+						"opentelekomcloud_identity_role_v3.role", "id"),
+					resource.TestCheckResourceAttr(dataPolicySimulationName, "all_allowed", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIdentityV3PolicySimulationDataSourceBasic = `
+resource "opentelekomcloud_identity_role_v3" "role" {
+  description   = "role"
+  display_name  = "policy_simulation_role"
+  display_layer = "domain"
+  statement {
+    effect = "Allow"
+    action = ["obs:object:*"]
+  }
+}
+
+data "opentelekomcloud_identity_policy_simulation_v3" "sim" {
+  policy_documents = [jsonencode({
+    Version = "1.1"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = ["ecs:*:list*", "ecs:servers:delete"]
+      },
+      {
+        Effect = "Deny"
+        Action = ["ecs:servers:delete"]
+      },
+    ]
+  })]
+  role_ids = [opentelekomcloud_identity_role_v3.role.id]
+
+  request {
+    action = "ecs:servers:list"
+  }
+  request {
+    action = "ecs:servers:delete"
+  }
+  request {
+    action   = "obs:object:GetObject"
+    resource = "obs:*:*:object:bucket/file"
+  }
+}
+`
@@ -0,0 +1,57 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataUsersName = "data.opentelekomcloud_identity_users_v3.users"
+
+func TestAccIdentityV3UsersDataSource_basic(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tf-user")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3UsersDataSourceBasic(userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataUsersName, "users.#", "1"),
+					resource.TestCheckResourceAttr(dataUsersName, "users.0.name", userName),
+					resource.TestCheckResourceAttrSet(dataUsersName, "users.0.mfa_device"),
+					resource.TestCheckResourceAttr(dataUsersName, "users_without_mfa.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityV3UsersDataSourceBasic(userName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_user_v3" "user" {
+  name     = "%s"
+  password = "password123@!"
+  enabled  = true
+}
+
+resource "opentelekomcloud_identity_virtual_mfa_device_v3" "device" {
+  name    = "%[1]s-device"
+  user_id = opentelekomcloud_identity_user_v3.user.id
+}
+
+data "opentelekomcloud_identity_users_v3" "users" {
+  name = opentelekomcloud_identity_user_v3.user.name
+
+  depends_on = [opentelekomcloud_identity_virtual_mfa_device_v3.device]
+}
+`, userName)
+}
@@ -59,6 +59,33 @@ func TestAccIdentityV3Credential_basic(t *testing.T) {
 	})
 }
 
+func TestAccIdentityV3Credential_rotation(t *testing.T) {
+	resourceName := "opentelekomcloud_identity_credential_v3.aksk"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			checkAKSKUnset(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccIdentityV3CredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3CredentialRotation,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.max_age_days", "90"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.overlap_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "current.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "current.0.access", resourceName, "access"),
+					resource.TestCheckResourceAttrPair(resourceName, "current.0.secret", resourceName, "secret"),
+					resource.TestCheckResourceAttr(resourceName, "current.0.status", "active"),
+					resource.TestCheckResourceAttr(resourceName, "previous.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccIdentityV3CredentialDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.IdentityV3Client(env.OS_REGION_NAME)
@@ -104,6 +131,16 @@ resource opentelekomcloud_identity_credential_v3 aksk {
   description = "This is one and unique test AK/SK"
   status  = "inactive"
 }
+`
+	testAccIdentityV3CredentialRotation = `
+resource opentelekomcloud_identity_credential_v3 aksk {
+  description = "This is one and unique test AK/SK"
+
+  rotation {
+    max_age_days = 90
+    overlap_days = 7
+  }
+}
 `
 	testAccIdentityV3CredentialUpdateDescription = `
 resource opentelekomcloud_identity_credential_v3 aksk {
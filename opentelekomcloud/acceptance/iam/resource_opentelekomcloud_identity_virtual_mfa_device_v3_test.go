@@ -0,0 +1,81 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3.0/security"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceVirtualMfaDeviceName = "opentelekomcloud_identity_virtual_mfa_device_v3.device"
+
+func TestAccIdentityV3VirtualMfaDevice_basic(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tf-user")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIdentityV3VirtualMfaDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3VirtualMfaDeviceBasic(userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceVirtualMfaDeviceName, "serial_number"),
+					resource.TestCheckResourceAttrSet(resourceVirtualMfaDeviceName, "base32_string_seed"),
+					resource.TestCheckResourceAttrSet(resourceVirtualMfaDeviceName, "qr_code_png"),
+					resource.TestCheckResourceAttrPair(resourceVirtualMfaDeviceName, "user_id",
+						"opentelekomcloud_identity_user_v3.user", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3VirtualMfaDeviceDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %w", err)
+	}
+
+	devices, err := security.ListUserMfaDevices(client)
+	if err != nil {
+		return fmt.Errorf("error listing virtual MFA devices: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_identity_virtual_mfa_device_v3" {
+			continue
+		}
+		for _, device := range devices {
+			if device.SerialNumber == rs.Primary.ID {
+				return fmt.Errorf("virtual MFA device still exists")
+			}
+		}
+	}
+	return nil
+}
+
+func testAccIdentityV3VirtualMfaDeviceBasic(userName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_user_v3" "user" {
+  name     = "%s"
+  password = "password123@!"
+  enabled  = true
+}
+
+resource "opentelekomcloud_identity_virtual_mfa_device_v3" "device" {
+  name    = "%[1]s-device"
+  user_id = opentelekomcloud_identity_user_v3.user.id
+}
+`, userName)
+}
@@ -0,0 +1,72 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSecretsName = "data.opentelekomcloud_kms_secrets_v1.secrets"
+
+func TestAccKmsSecretsV1DataSource_basic(t *testing.T) {
+	keyAlias := fmt.Sprintf("key_alias_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsSecretsV1DataSourceBasic(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_kms_ciphertext_v1.plain", "cipher_text"),
+					resource.TestCheckResourceAttr(dataSecretsName, "plaintext.%", "2"),
+					resource.TestCheckResourceAttr(dataSecretsName, "plaintext.plain", "my-secret"),
+					resource.TestCheckResourceAttr(dataSecretsName, "plaintext.with_context", "my-other-secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsSecretsV1DataSourceBasic(keyAlias string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key" {
+  key_alias    = "%s"
+  pending_days = "7"
+}
+
+data "opentelekomcloud_kms_ciphertext_v1" "plain" {
+  key_id     = opentelekomcloud_kms_key_v1.key.id
+  plain_text = "my-secret"
+}
+
+data "opentelekomcloud_kms_ciphertext_v1" "with_context" {
+  key_id     = opentelekomcloud_kms_key_v1.key.id
+  plain_text = "my-other-secret"
+
+  encryption_context = {
+    application = "terraform"
+  }
+}
+
+data "opentelekomcloud_kms_secrets_v1" "secrets" {
+  secret {
+    name        = "plain"
+    cipher_text = data.opentelekomcloud_kms_ciphertext_v1.plain.cipher_text
+  }
+
+  secret {
+    name        = "with_context"
+    cipher_text = data.opentelekomcloud_kms_ciphertext_v1.with_context.cipher_text
+
+    encryption_context = {
+      application = "terraform"
+    }
+  }
+}
+`, keyAlias)
+}
@@ -0,0 +1,104 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceKmsAliasName = "opentelekomcloud_kms_alias_v1.alias"
+
+func TestAccKmsAliasV1_basic(t *testing.T) {
+	suffix := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsAliasV1Basic(suffix, "key_1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceKmsAliasName, "alias", "alias/tf-"+suffix),
+					resource.TestCheckResourceAttrPair(resourceKmsAliasName, "key_id",
+						"opentelekomcloud_kms_key_v1.key_1", "id"),
+					resource.TestCheckResourceAttrSet(resourceKmsAliasName, "alias_urn"),
+				),
+			},
+			{
+				Config: testAccKmsAliasV1Basic(suffix, "key_2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceKmsAliasName, "key_id",
+						"opentelekomcloud_kms_key_v1.key_2", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceKmsAliasName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKmsKeyV1_asymmetric(t *testing.T) {
+	resourceName := "opentelekomcloud_kms_key_v1.signing"
+	keyAlias := fmt.Sprintf("kms_signing_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKeyV1Asymmetric(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key_spec", "EC_P256"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", "SIGN_VERIFY"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_key"),
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_kms_signature_v1.signature", "signature"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsAliasV1Basic(suffix, key string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias    = "tf-key-1-%[1]s"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_kms_key_v1" "key_2" {
+  key_alias    = "tf-key-2-%[1]s"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_kms_alias_v1" "alias" {
+  alias  = "alias/tf-%[1]s"
+  key_id = opentelekomcloud_kms_key_v1.%[2]s.id
+}
+`, suffix, key)
+}
+
+func testAccKmsKeyV1Asymmetric(keyAlias string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "signing" {
+  key_alias    = "%s"
+  key_spec     = "EC_P256"
+  key_usage    = "SIGN_VERIFY"
+  pending_days = "7"
+}
+
+data "opentelekomcloud_kms_signature_v1" "signature" {
+  key_id            = opentelekomcloud_kms_key_v1.signing.id
+  message           = base64encode("terraform")
+  signing_algorithm = "ECDSA_SHA_256"
+}
+`, keyAlias)
+}
@@ -0,0 +1,50 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const (
+	dataPrivateGatewayName    = "data.opentelekomcloud_nat_private_gateway_v3.gw"
+	dataPrivateTransitIPsName = "data.opentelekomcloud_nat_private_transit_ips_v3.tips"
+)
+
+func TestAccDataSourceNatPrivateGateway_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNatPrivateGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatPrivateRulesBase,
+			},
+			{
+				Config: testAccDataSourceNatPrivateGatewayBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataPrivateGatewayName, "id", resourcePrivateGatewayName, "id"),
+					resource.TestCheckResourceAttr(dataPrivateGatewayName, "name", "private_nat_rules_gw"),
+					resource.TestCheckResourceAttrSet(dataPrivateGatewayName, "vpc_id"),
+					resource.TestCheckResourceAttr(dataPrivateTransitIPsName, "transit_ips.#", "1"),
+					resource.TestCheckResourceAttr(dataPrivateTransitIPsName, "transit_ips.0.ip_address", "172.20.0.10"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNatPrivateGatewayBasic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_nat_private_gateway_v3" "gw" {
+  name = opentelekomcloud_nat_private_gateway_v3.gw.name
+}
+
+data "opentelekomcloud_nat_private_transit_ips_v3" "tips" {
+  transit_ip_id = opentelekomcloud_nat_private_transit_ip_v3.tip.id
+}
+`, testAccNatPrivateRulesBase)
@@ -0,0 +1,255 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/nat"
+)
+
+const (
+	resourcePrivateGatewayName   = "opentelekomcloud_nat_private_gateway_v3.gw"
+	resourcePrivateTransitIPName = "opentelekomcloud_nat_private_transit_ip_v3.tip"
+	resourcePrivateSnatRuleName  = "opentelekomcloud_nat_private_snat_rule_v3.snat"
+	resourcePrivateDnatRuleName  = "opentelekomcloud_nat_private_dnat_rule_v3.dnat"
+)
+
+func TestAccNatPrivateGateway_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNatPrivateGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatPrivateGatewayBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatPrivateGatewayExists(resourcePrivateGatewayName),
+					resource.TestCheckResourceAttr(resourcePrivateGatewayName, "name", "private_nat_gw"),
+					resource.TestCheckResourceAttr(resourcePrivateGatewayName, "spec", "Small"),
+					resource.TestCheckResourceAttr(resourcePrivateGatewayName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourcePrivateGatewayName, "tags.muh", "kuh"),
+					resource.TestCheckResourceAttrSet(resourcePrivateGatewayName, "vpc_id"),
+				),
+			},
+			{
+				Config: testAccNatPrivateGatewayUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePrivateGatewayName, "name", "private_nat_gw_updated"),
+					resource.TestCheckResourceAttr(resourcePrivateGatewayName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourcePrivateGatewayName, "spec", "Medium"),
+					resource.TestCheckResourceAttr(resourcePrivateGatewayName, "tags.muh", "muh"),
+				),
+			},
+			{
+				ResourceName:      resourcePrivateGatewayName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNatPrivateRules_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNatPrivateGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatPrivateRulesBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePrivateTransitIPName, "ip_address", "172.20.0.10"),
+					resource.TestCheckResourceAttrSet(resourcePrivateTransitIPName, "network_interface_id"),
+					resource.TestCheckResourceAttr(resourcePrivateSnatRuleName, "transit_ip_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourcePrivateSnatRuleName, "transit_ip_addresses.0", "172.20.0.10"),
+					resource.TestCheckResourceAttr(resourcePrivateDnatRuleName, "protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourcePrivateDnatRuleName, "transit_service_port", "1000-1010"),
+					resource.TestCheckResourceAttr(resourcePrivateDnatRuleName, "internal_service_port", "2000-2010"),
+				),
+			},
+			{
+				Config: testAccNatPrivateRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePrivateSnatRuleName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourcePrivateDnatRuleName, "protocol", "any"),
+					resource.TestCheckResourceAttr(resourcePrivateDnatRuleName, "transit_service_port", "0"),
+					resource.TestCheckResourceAttr(resourcePrivateDnatRuleName, "internal_service_port", "0"),
+				),
+			},
+			{
+				ResourceName:      resourcePrivateTransitIPName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourcePrivateSnatRuleName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourcePrivateDnatRuleName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNatPrivateGatewayDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.NatV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NATv3 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		var err error
+		switch rs.Type {
+		case "opentelekomcloud_nat_private_gateway_v3":
+			_, err = nat.GetPrivateGateway(client, rs.Primary.ID)
+		case "opentelekomcloud_nat_private_transit_ip_v3":
+			_, err = nat.GetTransitIP(client, rs.Primary.ID)
+		case "opentelekomcloud_nat_private_snat_rule_v3":
+			_, err = nat.GetPrivateSnatRule(client, rs.Primary.ID)
+		case "opentelekomcloud_nat_private_dnat_rule_v3":
+			_, err = nat.GetPrivateDnatRule(client, rs.Primary.ID)
+		default:
+			continue
+		}
+		if err == nil {
+			return fmt.Errorf("%s %s still exists", rs.Type, rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNatPrivateGatewayExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.NatV3Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud NATv3 client: %w", err)
+		}
+
+		found, err := nat.GetPrivateGateway(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("private NAT gateway not found")
+		}
+
+		return nil
+	}
+}
+
+var testAccNatPrivateGatewayBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_nat_private_gateway_v3" "gw" {
+  name      = "private_nat_gw"
+  spec      = "Small"
+  subnet_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id
+
+  tags = {
+    muh = "kuh"
+  }
+}
+`, common.DataSourceSubnet)
+
+var testAccNatPrivateGatewayUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_nat_private_gateway_v3" "gw" {
+  name        = "private_nat_gw_updated"
+  description = "updated"
+  spec        = "Medium"
+  subnet_id   = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id
+
+  tags = {
+    muh = "muh"
+  }
+}
+`, common.DataSourceSubnet)
+
+var testAccNatPrivateRulesBase = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_v1" "transit" {
+  name = "private_nat_transit_vpc"
+  cidr = "172.20.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "transit" {
+  name       = "private_nat_transit_subnet"
+  vpc_id     = opentelekomcloud_vpc_v1.transit.id
+  cidr       = "172.20.0.0/24"
+  gateway_ip = "172.20.0.1"
+}
+
+resource "opentelekomcloud_nat_private_gateway_v3" "gw" {
+  name      = "private_nat_rules_gw"
+  subnet_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id
+}
+
+resource "opentelekomcloud_nat_private_transit_ip_v3" "tip" {
+  subnet_id  = opentelekomcloud_vpc_subnet_v1.transit.id
+  ip_address = "172.20.0.10"
+}
+`, common.DataSourceSubnet)
+
+var testAccNatPrivateRulesBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_nat_private_snat_rule_v3" "snat" {
+  gateway_id     = opentelekomcloud_nat_private_gateway_v3.gw.id
+  cidr           = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.cidr
+  transit_ip_ids = [opentelekomcloud_nat_private_transit_ip_v3.tip.id]
+}
+
+resource "opentelekomcloud_nat_private_dnat_rule_v3" "dnat" {
+  gateway_id            = opentelekomcloud_nat_private_gateway_v3.gw.id
+  transit_ip_id         = opentelekomcloud_nat_private_transit_ip_v3.tip.id
+  private_ip_address    = cidrhost(data.opentelekomcloud_vpc_subnet_v1.shared_subnet.cidr, 10)
+  protocol              = "tcp"
+  transit_service_port  = "1000-1010"
+  internal_service_port = "2000-2010"
+}
+`, testAccNatPrivateRulesBase)
+
+var testAccNatPrivateRulesUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_nat_private_snat_rule_v3" "snat" {
+  gateway_id     = opentelekomcloud_nat_private_gateway_v3.gw.id
+  cidr           = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.cidr
+  description    = "updated"
+  transit_ip_ids = [opentelekomcloud_nat_private_transit_ip_v3.tip.id]
+}
+
+resource "opentelekomcloud_nat_private_dnat_rule_v3" "dnat" {
+  gateway_id            = opentelekomcloud_nat_private_gateway_v3.gw.id
+  transit_ip_id         = opentelekomcloud_nat_private_transit_ip_v3.tip.id
+  private_ip_address    = cidrhost(data.opentelekomcloud_vpc_subnet_v1.shared_subnet.cidr, 10)
+  protocol              = "any"
+  transit_service_port  = "0"
+  internal_service_port = "0"
+}
+`, testAccNatPrivateRulesBase)
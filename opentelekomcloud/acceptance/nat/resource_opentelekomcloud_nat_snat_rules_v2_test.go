@@ -0,0 +1,109 @@
+package acceptance
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/snatrules"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceSnatRulesName = "opentelekomcloud_nat_snat_rules_v2.rules"
+
+func TestAccNatSnatRules_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNatV2SnatRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatV2SnatRulesBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSnatRulesName, "floating_ip_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceSnatRulesName, "cidrs.#", "2"),
+					resource.TestCheckResourceAttr(resourceSnatRulesName, "rules.#", "2"),
+				),
+			},
+			{
+				Config: testAccNatV2SnatRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSnatRulesName, "floating_ip_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceSnatRulesName, "cidrs.#", "1"),
+					resource.TestCheckResourceAttr(resourceSnatRulesName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceSnatRulesName, "rules.0.cidr", "192.168.10.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNatV2SnatRulesDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.NatV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NATv2 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_nat_snat_rules_v2" {
+			continue
+		}
+
+		for key, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "rules.") || !strings.HasSuffix(key, ".id") {
+				continue
+			}
+			if _, err := snatrules.Get(client, id); err == nil {
+				return fmt.Errorf("snat rule %s still exists", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+var testAccNatV2SnatRulesGateway = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_floatingip_v2" "fip_1" {}
+
+resource "opentelekomcloud_networking_floatingip_v2" "fip_2" {}
+
+resource "opentelekomcloud_nat_gateway_v2" "nat_1" {
+  name                = "nat_snat_rules"
+  spec                = "1"
+  router_id           = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+  internal_network_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id
+}
+`, common.DataSourceSubnet)
+
+var testAccNatV2SnatRulesBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_nat_snat_rules_v2" "rules" {
+  nat_gateway_id = opentelekomcloud_nat_gateway_v2.nat_1.id
+  source_type    = 1
+  floating_ip_ids = [
+    opentelekomcloud_networking_floatingip_v2.fip_1.id,
+    opentelekomcloud_networking_floatingip_v2.fip_2.id,
+  ]
+  cidrs = ["192.168.10.0/24", "192.168.20.0/24"]
+}
+`, testAccNatV2SnatRulesGateway)
+
+var testAccNatV2SnatRulesUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_nat_snat_rules_v2" "rules" {
+  nat_gateway_id  = opentelekomcloud_nat_gateway_v2.nat_1.id
+  source_type     = 1
+  floating_ip_ids = [opentelekomcloud_networking_floatingip_v2.fip_1.id]
+  cidrs           = ["192.168.10.0/24"]
+}
+`, testAccNatV2SnatRulesGateway)
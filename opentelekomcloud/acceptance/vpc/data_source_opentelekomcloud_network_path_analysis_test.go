@@ -0,0 +1,99 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const (
+	dataPathAllowedName = "data.opentelekomcloud_network_path_analysis.allowed"
+	dataPathDeniedName  = "data.opentelekomcloud_network_path_analysis.denied"
+)
+
+func TestAccNetworkPathAnalysisDataSource_basic(t *testing.T) {
+	t.Parallel()
+	quotas.BookMany(t, quotas.MultipleQuotas{
+		{Q: quotas.SecurityGroup, Count: 2},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkPathAnalysisDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataPathAllowedName, "reachable", "true"),
+					resource.TestCheckResourceAttr(dataPathAllowedName, "hops.0.component", "security_group"),
+					resource.TestCheckResourceAttr(dataPathAllowedName, "hops.0.decision", "allow"),
+					resource.TestCheckResourceAttr(dataPathAllowedName, "hops.1.component", "vpc"),
+					resource.TestCheckResourceAttrPair(dataPathAllowedName, "hops.2.rule_id", "opentelekomcloud_networking_secgroup_rule_v2.db_postgres", "id"),
+					resource.TestCheckResourceAttr(dataPathDeniedName, "reachable", "false"),
+					resource.TestCheckResourceAttr(dataPathDeniedName, "hops.2.decision", "deny"),
+				),
+			},
+		},
+	})
+}
+
+var testAccNetworkPathAnalysisDataSourceBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_secgroup_v2" "web" {
+  name = "tf-acc-path-web"
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "db" {
+  name                 = "tf-acc-path-db"
+  delete_default_rules = true
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "db_postgres" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.db.id
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  port_range_min    = 5432
+  port_range_max    = 5432
+  remote_group_id   = opentelekomcloud_networking_secgroup_v2.web.id
+}
+
+resource "opentelekomcloud_networking_port_v2" "web" {
+  name               = "tf-acc-path-web"
+  network_id         = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id
+  security_group_ids = [opentelekomcloud_networking_secgroup_v2.web.id]
+  fixed_ip {
+    subnet_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.subnet_id
+  }
+}
+
+resource "opentelekomcloud_networking_port_v2" "db" {
+  name               = "tf-acc-path-db"
+  network_id         = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id
+  security_group_ids = [opentelekomcloud_networking_secgroup_v2.db.id]
+  fixed_ip {
+    subnet_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.subnet_id
+  }
+}
+
+data "opentelekomcloud_network_path_analysis" "allowed" {
+  source_port_id   = opentelekomcloud_networking_port_v2.web.id
+  destination_ip   = opentelekomcloud_networking_port_v2.db.all_fixed_ips[0]
+  destination_port = 5432
+
+  depends_on = [opentelekomcloud_networking_secgroup_rule_v2.db_postgres]
+}
+
+data "opentelekomcloud_network_path_analysis" "denied" {
+  source_ip        = opentelekomcloud_networking_port_v2.web.all_fixed_ips[0]
+  destination_ip   = opentelekomcloud_networking_port_v2.db.all_fixed_ips[0]
+  destination_port = 22
+
+  depends_on = [opentelekomcloud_networking_secgroup_rule_v2.db_postgres]
+}
+`, common.DataSourceSubnet)
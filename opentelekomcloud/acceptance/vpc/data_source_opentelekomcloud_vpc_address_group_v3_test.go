@@ -0,0 +1,45 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataAddressGroupName = "data.opentelekomcloud_vpc_address_group_v3.group"
+
+func TestAccVpcAddressGroupV3DataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-ag-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcAddressGroupV3DataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataAddressGroupName, "id", resourceAddressGroupName, "id"),
+					resource.TestCheckResourceAttr(dataAddressGroupName, "addresses.#", "1"),
+					resource.TestCheckResourceAttr(dataAddressGroupName, "ip_version", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpcAddressGroupV3DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_address_group_v3" "group" {
+  name      = "%s"
+  addresses = ["192.168.10.0/24"]
+}
+
+data "opentelekomcloud_vpc_address_group_v3" "group" {
+  name = opentelekomcloud_vpc_address_group_v3.group.name
+}
+`, name)
+}
@@ -0,0 +1,125 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceNwSGRulesName = "opentelekomcloud_networking_secgroup_rules_v2.rules"
+
+func TestAccNetworkingV2SecGroupRules_basic(t *testing.T) {
+	t.Parallel()
+	quotas.BookOne(t, quotas.SecurityGroup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRulesBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNwSGRulesName, "rule.#", "3"),
+					testAccCheckNetworkingV2SecGroupRulesCount(resourceNwSGRulesName, 6),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SecGroupRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNwSGRulesName, "rule.#", "1"),
+					testAccCheckNetworkingV2SecGroupRulesCount(resourceNwSGRulesName, 2),
+				),
+			},
+			{
+				ResourceName:      resourceNwSGRulesName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// imported rules are not grouped into the configured blocks
+				ImportStateVerifyIgnore: []string{"rule"},
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2SecGroupRulesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.NetworkingV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+		}
+
+		pages, err := rules.List(client, rules.ListOpts{SecGroupID: rs.Primary.ID}).AllPages()
+		if err != nil {
+			return err
+		}
+		found, err := rules.ExtractRules(pages)
+		if err != nil {
+			return err
+		}
+		if len(found) != count {
+			return fmt.Errorf("expected %d rules in the security group, got %d", count, len(found))
+		}
+		return nil
+	}
+}
+
+const testAccNetworkingV2SecGroupRulesBasic = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name        = "secgroup_rules"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+
+  rule {
+    direction          = "ingress"
+    protocol           = "tcp"
+    ports              = ["80", "443"]
+    remote_ip_prefixes = ["10.0.0.0/24", "10.0.1.0/24"]
+  }
+
+  rule {
+    direction = "egress"
+    ethertype = "IPv4"
+  }
+
+  rule {
+    direction = "egress"
+    ethertype = "IPv6"
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRulesUpdate = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name        = "secgroup_rules"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+
+  rule {
+    direction          = "ingress"
+    protocol           = "tcp"
+    ports              = ["22", "8000-8080"]
+    remote_ip_prefixes = ["10.0.0.0/24"]
+  }
+}
+`
@@ -0,0 +1,118 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpc"
+)
+
+const resourceAddressGroupName = "opentelekomcloud_vpc_address_group_v3.group"
+
+func getVpcAddressGroupV3ResourceFunc(conf *cfg.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.NetworkingV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud NetworkingV3 client: %w", err)
+	}
+	return vpc.GetAddressGroup(client, state.Primary.ID)
+}
+
+func TestAccVpcAddressGroupV3_basic(t *testing.T) {
+	var group vpc.AddressGroup
+	name := fmt.Sprintf("tf-acc-ag-%s", acctest.RandString(5))
+	rc := common.InitResourceCheck(resourceAddressGroupName, &group, getVpcAddressGroupV3ResourceFunc)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcAddressGroupV3Basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceAddressGroupName, "name", name),
+					resource.TestCheckResourceAttr(resourceAddressGroupName, "ip_version", "4"),
+					resource.TestCheckResourceAttr(resourceAddressGroupName, "addresses.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_networking_secgroup_rule_v2.rule", "remote_address_group_id",
+						resourceAddressGroupName, "id",
+					),
+				),
+			},
+			{
+				Config: testAccVpcAddressGroupV3Updated(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceAddressGroupName, "name", name+"-updated"),
+					resource.TestCheckResourceAttr(resourceAddressGroupName, "description", "partner allowlist"),
+					resource.TestCheckResourceAttr(resourceAddressGroupName, "addresses.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceAddressGroupName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "opentelekomcloud_networking_secgroup_rule_v2.rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVpcAddressGroupV3Basic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_address_group_v3" "group" {
+  name      = "%[1]s"
+  addresses = ["192.168.10.0/24", "192.168.20.1-192.168.20.10"]
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "%[1]s"
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "rule" {
+  direction               = "ingress"
+  ethertype               = "IPv4"
+  protocol                = "tcp"
+  port_range_min          = 443
+  port_range_max          = 443
+  remote_address_group_id = opentelekomcloud_vpc_address_group_v3.group.id
+  security_group_id       = opentelekomcloud_networking_secgroup_v2.secgroup.id
+}
+`, name)
+}
+
+func testAccVpcAddressGroupV3Updated(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_address_group_v3" "group" {
+  name        = "%[1]s-updated"
+  description = "partner allowlist"
+  addresses   = ["192.168.10.0/24", "192.168.20.1-192.168.20.10", "172.16.0.1"]
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "%[1]s"
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "rule" {
+  direction               = "ingress"
+  ethertype               = "IPv4"
+  protocol                = "tcp"
+  port_range_min          = 443
+  port_range_max          = 443
+  remote_address_group_id = opentelekomcloud_vpc_address_group_v3.group.id
+  security_group_id       = opentelekomcloud_networking_secgroup_v2.secgroup.id
+}
+`, name)
+}
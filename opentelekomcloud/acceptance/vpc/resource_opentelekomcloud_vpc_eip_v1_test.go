@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -96,6 +97,20 @@ func TestAccVpcV1EIP_timeout(t *testing.T) {
 	})
 }
 
+func TestAccVpcV1EIP_bandwidthValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVpcV1EIPBandwidthValidation,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("either bandwidth `id` or both `name` and `size` must be set"),
+			},
+		},
+	})
+}
+
 func testAccCheckVpcV1EIPDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	networkingClient, err := config.NetworkingV1Client(env.OS_REGION_NAME)
@@ -168,6 +183,18 @@ resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
 }
 `
 
+const testAccVpcV1EIPBandwidthValidation = `
+resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    size       = 8
+    share_type = "PER"
+  }
+}
+`
+
 const testAccVpcV1EIPUpdate = `
 resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
   publicip {
@@ -0,0 +1,50 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccWafDedicatedEventsV1_basic(t *testing.T) {
+	events := "data.opentelekomcloud_waf_dedicated_events_v1.events"
+	topAttacks := "data.opentelekomcloud_waf_dedicated_top_attacks_v1.top"
+	qps := "data.opentelekomcloud_waf_dedicated_qps_v1.qps"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafDedicatedEventsV1Basic,
+				Check: resource.ComposeTestCheckFunc(
+					common.InitDataSourceCheck(events).CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(events, "events.#"),
+					common.InitDataSourceCheck(topAttacks).CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(topAttacks, "urls.#"),
+					resource.TestCheckResourceAttrSet(topAttacks, "ips.#"),
+					common.InitDataSourceCheck(qps).CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(qps, "statistics.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccWafDedicatedEventsV1Basic = `
+data "opentelekomcloud_waf_dedicated_events_v1" "events" {
+  recent = "1week"
+}
+
+data "opentelekomcloud_waf_dedicated_top_attacks_v1" "top" {
+  recent = "3days"
+  top    = 10
+}
+
+data "opentelekomcloud_waf_dedicated_qps_v1" "qps" {
+  begin_time = "2024-01-01T00:00:00Z"
+  end_time   = "2024-01-02T00:00:00Z"
+}
+`
@@ -0,0 +1,169 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	wafdPolicyBundleName           = "opentelekomcloud_waf_dedicated_policy_bundle_v1.bundle"
+	wafdPolicyBundleDataSourceName = "data.opentelekomcloud_waf_dedicated_policy_bundle_v1.source"
+)
+
+func TestAccWafDedicatedPolicyBundleV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckWafDedicatedPolicyBundleV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafDedicatedPolicyBundleV1Export,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(wafdPolicyBundleDataSourceName, "document"),
+					resource.TestCheckResourceAttrPair(wafdPolicyBundleName, "document",
+						wafdPolicyBundleDataSourceName, "document"),
+					testAccCheckWafDedicatedPolicyBundleV1RuleCount(wafdPolicyBundleName, 1, 1),
+				),
+			},
+			{
+				Config: testAccWafDedicatedPolicyBundleV1Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWafDedicatedPolicyBundleV1RuleCount(wafdPolicyBundleName, 2, 0),
+				),
+			},
+			{
+				ResourceName:            wafdPolicyBundleName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"document"},
+			},
+		},
+	})
+}
+
+func testAccCheckWafDedicatedPolicyBundleV1RuleCount(n string, blacklist, geoIP int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.WafDedicatedV1Client(env.OS_REGION_NAME)
+		if err != nil {
+			return err
+		}
+
+		foundBlacklist, err := rules.ListBlacklists(client, rs.Primary.ID, rules.ListBlacklistOpts{})
+		if err != nil {
+			return err
+		}
+		if len(foundBlacklist) != blacklist {
+			return fmt.Errorf("expected %d blacklist rules, got %d", blacklist, len(foundBlacklist))
+		}
+		foundGeoIP, err := rules.ListGeoIp(client, rs.Primary.ID, rules.ListGeoIpOpts{})
+		if err != nil {
+			return err
+		}
+		if len(foundGeoIP) != geoIP {
+			return fmt.Errorf("expected %d geo IP rules, got %d", geoIP, len(foundGeoIP))
+		}
+		return nil
+	}
+}
+
+func testAccCheckWafDedicatedPolicyBundleV1Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_waf_dedicated_policy_bundle_v1" {
+			continue
+		}
+
+		found, err := rules.ListBlacklists(client, rs.Primary.ID, rules.ListBlacklistOpts{})
+		if err == nil && len(found) != 0 {
+			return fmt.Errorf("waf dedicated policy bundle rules still exist")
+		}
+	}
+
+	return nil
+}
+
+const testAccWafDedicatedPolicyBundleV1Policies = `
+resource "opentelekomcloud_waf_dedicated_policy_v1" "source" {
+  name = "policy_bundle_source"
+}
+
+resource "opentelekomcloud_waf_dedicated_policy_v1" "target" {
+  name = "policy_bundle_target"
+}
+`
+
+var testAccWafDedicatedPolicyBundleV1Export = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_waf_dedicated_blacklist_rule_v1" "rule" {
+  policy_id  = opentelekomcloud_waf_dedicated_policy_v1.source.id
+  name       = "blocked"
+  ip_address = "192.168.1.0/24"
+  action     = 0
+}
+
+resource "opentelekomcloud_waf_dedicated_geo_ip_rule_v1" "rule" {
+  policy_id   = opentelekomcloud_waf_dedicated_policy_v1.source.id
+  name        = "geo"
+  region_code = "BR"
+  action      = 0
+}
+
+data "opentelekomcloud_waf_dedicated_policy_bundle_v1" "source" {
+  policy_id = opentelekomcloud_waf_dedicated_policy_v1.source.id
+
+  depends_on = [
+    opentelekomcloud_waf_dedicated_blacklist_rule_v1.rule,
+    opentelekomcloud_waf_dedicated_geo_ip_rule_v1.rule,
+  ]
+}
+
+resource "opentelekomcloud_waf_dedicated_policy_bundle_v1" "bundle" {
+  policy_id = opentelekomcloud_waf_dedicated_policy_v1.target.id
+  document  = data.opentelekomcloud_waf_dedicated_policy_bundle_v1.source.document
+}
+`, testAccWafDedicatedPolicyBundleV1Policies)
+
+var testAccWafDedicatedPolicyBundleV1Update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_waf_dedicated_policy_bundle_v1" "bundle" {
+  policy_id = opentelekomcloud_waf_dedicated_policy_v1.target.id
+  document = jsonencode({
+    rules = {
+      blacklist = [
+        {
+          name  = "blocked"
+          addr  = "192.168.2.0/24"
+          white = 0
+        },
+        {
+          name   = "allowed"
+          addr   = "10.0.0.0/8"
+          white  = 1
+          status = 0
+        },
+      ]
+    }
+  })
+}
+`, testAccWafDedicatedPolicyBundleV1Policies)
@@ -736,6 +736,16 @@ func (c *Config) DnsV2Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// DnsV21Client is used for the endpoints and resolver rules, which are available in v2.1 API only
+func (c *Config) DnsV21Client(region string) (*golangsdk.ServiceClient, error) {
+	service, err := c.DnsV2Client(region)
+	if err != nil {
+		return nil, err
+	}
+	service.ResourceBase = service.Endpoint + "v2.1/"
+	return service, nil
+}
+
 func (c *Config) GaussDBV3Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewGaussDBV3(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -845,6 +855,21 @@ func (c *Config) KmsKeyV1Client(region string) (*golangsdk.ServiceClient, error)
 	})
 }
 
+// CsmsV1Client is used for the secrets API, which shares the endpoint with KMS
+func (c *Config) CsmsV1Client(region string) (*golangsdk.ServiceClient, error) {
+	service, err := c.KmsKeyV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := url.Parse(service.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	service.Endpoint = fmt.Sprintf("%s://%s/v1/", endpoint.Scheme, endpoint.Host)
+	service.ResourceBase = fmt.Sprintf("%s%s/", service.Endpoint, c.HwClient.ProjectID)
+	return service, nil
+}
+
 func (c *Config) NatV2Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewNatV2(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -852,6 +877,21 @@ func (c *Config) NatV2Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// NatV3Client is used for the private NAT gateways API, missing in the service catalog
+func (c *Config) NatV3Client(region string) (*golangsdk.ServiceClient, error) {
+	service, err := c.NatV2Client(region)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := url.Parse(service.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	service.Endpoint = fmt.Sprintf("%s://%s/v3/", endpoint.Scheme, endpoint.Host)
+	service.ResourceBase = fmt.Sprintf("%s%s/", service.Endpoint, c.HwClient.ProjectID)
+	return service, nil
+}
+
 func (c *Config) OrchestrationV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewOrchestrationV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -1231,6 +1271,17 @@ func (c *Config) TmsV1Client() (*golangsdk.ServiceClient, error) {
 	return service, nil
 }
 
+// RamV1Client is used for the resource sharing API, missing in the service catalog
+func (c *Config) RamV1Client() (*golangsdk.ServiceClient, error) {
+	service, err := c.IdentityV3Client()
+	if err != nil {
+		return nil, err
+	}
+	service.Endpoint = strings.Replace(service.Endpoint, "v3/", "v1/", 1)
+	service.Endpoint = strings.Replace(service.Endpoint, "iam", "ram", 1)
+	return service, nil
+}
+
 func (c *Config) EvpnV5Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewEVPNServiceV3(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
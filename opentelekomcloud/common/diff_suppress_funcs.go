@@ -1,6 +1,7 @@
 package common
 
 import (
+	"net"
 	"reflect"
 	"regexp"
 	"sort"
@@ -19,6 +20,23 @@ func SuppressEquivalentAwsPolicyDiffs(_, old, new string, _ *schema.ResourceData
 	return equivalent
 }
 
+// SuppressEquivalentPolicyDiffs suppresses diff between equivalent AWS-like (e.g. OBS bucket policy)
+// or OTC IAM/KMS/SMN policy documents, ignoring whitespaces and ordering of the lists
+func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if SuppressEquivalentAwsPolicyDiffs(k, old, new, d) {
+		return true
+	}
+	oldPolicy, err := NormalizePolicyJsonString(old)
+	if err != nil {
+		return false
+	}
+	newPolicy, err := NormalizePolicyJsonString(new)
+	if err != nil {
+		return false
+	}
+	return oldPolicy == newPolicy
+}
+
 // SuppressDiffAll suppress all changes?
 func SuppressDiffAll(_, _, _ string, _ *schema.ResourceData) bool {
 	return true
@@ -90,6 +108,16 @@ func SuppressEqualZoneNames(_, old, new string, _ *schema.ResourceData) bool {
 	return oldShort == newShort
 }
 
+// SuppressEquivalentIPAddresses suppresses diff between different notations of the same IP address, e.g. `2001:db8::1` and `2001:0db8:0:0:0:0:0:1`
+func SuppressEquivalentIPAddresses(_, old, new string, _ *schema.ResourceData) bool {
+	oldIP := net.ParseIP(old)
+	newIP := net.ParseIP(new)
+	if oldIP == nil || newIP == nil {
+		return old == new
+	}
+	return oldIP.Equal(newIP)
+}
+
 func SuppressStrippedNewLines(_, old, new string, _ *schema.ResourceData) bool {
 	newline := "\n"
 	return strings.Trim(old, newline) == strings.Trim(new, newline)
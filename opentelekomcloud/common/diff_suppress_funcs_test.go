@@ -0,0 +1,38 @@
+package common
+
+import (
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestSuppressEquivalentPolicyDiffs(t *testing.T) {
+	old := `{"Version": "1.1", "Statement": [
+		{"Effect": "Deny", "Action": ["ecs:servers:delete"]},
+		{"Effect": "Allow", "Action": ["ecs:*:list*", "ecs:*:get*"],
+		 "Condition": {"StringEquals": {"g:UserName": ["admin", "root"]}}}
+	]}`
+	cases := []struct {
+		name     string
+		new      string
+		expected bool
+	}{
+		{"reordered", `{"Statement":[{"Action":["ecs:*:get*","ecs:*:list*"],"Effect":"Allow",
+			"Condition":{"StringEquals":{"g:UserName":["root","admin","root"]}}},
+			{"Action":"ecs:servers:delete","Effect":"Deny"}],"Version":"1.1"}`, true},
+		{"different action", `{"Version": "1.1", "Statement": [
+			{"Effect": "Deny", "Action": ["ecs:servers:stop"]},
+			{"Effect": "Allow", "Action": ["ecs:*:list*", "ecs:*:get*"],
+			 "Condition": {"StringEquals": {"g:UserName": ["admin", "root"]}}}]}`, false},
+		{"different effect", `{"Version": "1.1", "Statement": [
+			{"Effect": "Allow", "Action": ["ecs:servers:delete"]},
+			{"Effect": "Allow", "Action": ["ecs:*:list*", "ecs:*:get*"],
+			 "Condition": {"StringEquals": {"g:UserName": ["admin", "root"]}}}]}`, false},
+		{"invalid", `{"Version": `, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			th.AssertEquals(t, c.expected, SuppressEquivalentPolicyDiffs("policy", old, c.new, nil))
+		})
+	}
+}
@@ -2,6 +2,9 @@ package common
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
@@ -41,6 +44,62 @@ func NormalizeJsonString(jsonString interface{}) (string, error) {
 	return string(bytes), nil
 }
 
+// NormalizePolicyJsonString returns canonical form of IAM/OBS/KMS policy document:
+// scalar values are converted to single-element string lists, lists are sorted and
+// deduplicated, so documents differing only in formatting or ordering are equal
+func NormalizePolicyJsonString(policy string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+	var j interface{}
+	if err := decoder.Decode(&j); err != nil {
+		return policy, err
+	}
+	bytes, err := json.Marshal(normalizePolicyValue(j))
+	if err != nil {
+		return policy, err
+	}
+	return string(bytes), nil
+}
+
+func normalizePolicyValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			result[k] = normalizePolicyValue(item)
+		}
+		return result
+	case []interface{}:
+		seen := make(map[string]bool)
+		var keys []string
+		result := make(map[string]interface{})
+		for _, item := range value {
+			normalized := normalizePolicyValue(item)
+			// single-element lists are flattened, so ["a", ["b"]] equals to ["a", "b"]
+			if list, ok := normalized.([]interface{}); ok && len(list) == 1 {
+				normalized = list[0]
+			}
+			key, _ := json.Marshal(normalized)
+			if seen[string(key)] {
+				continue
+			}
+			seen[string(key)] = true
+			keys = append(keys, string(key))
+			result[string(key)] = normalized
+		}
+		sort.Strings(keys)
+		list := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			list = append(list, result[key])
+		}
+		return list
+	case nil:
+		return []interface{}{}
+	default:
+		return []interface{}{fmt.Sprint(value)}
+	}
+}
+
 // CheckYamlString Takes a value containing YAML string and passes it through
 // the YAML parser. Returns either a parsing
 // error or original YAML string.
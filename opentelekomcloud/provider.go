@@ -15,6 +15,7 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/cce"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ces"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/csbs"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/csms"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/css"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/cts"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dcaas"
@@ -282,6 +283,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_compute_instances_v2":               ecs.DataSourceComputeInstancesV2(),
 			"opentelekomcloud_csbs_backup_v1":                     csbs.DataSourceCSBSBackupV1(),
 			"opentelekomcloud_csbs_backup_policy_v1":              csbs.DataSourceCSBSBackupPolicyV1(),
+			"opentelekomcloud_csms_secret_version_v1":             csms.DataSourceCsmsSecretVersionV1(),
 			"opentelekomcloud_css_certificate_v1":                 css.DataSourceCSSCertificateV1(),
 			"opentelekomcloud_css_flavor_v1":                      css.DataSourceCSSFlavorV1(),
 			"opentelekomcloud_cts_tracker_v1":                     cts.DataSourceCTSTrackerV1(),
@@ -304,33 +306,56 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_dns_nameservers_v2":                 dns.DataSourceDNSNameserversV2(),
 			"opentelekomcloud_dns_zone_v2":                        dns.DataSourceDNSZoneV2(),
 			"opentelekomcloud_dws_flavors_v2":                     dws.DataSourceDwsFlavorsV2(),
+			"opentelekomcloud_er_attachments_v3":                  er.DataSourceErAttachmentsV3(),
+			"opentelekomcloud_er_instances_v3":                    er.DataSourceErInstancesV3(),
+			"opentelekomcloud_er_route_tables_v3":                 er.DataSourceErRouteTablesV3(),
+			"opentelekomcloud_gaussdb_mysql_flavors_v3":           gaussdb.DataSourceGaussDBFlavorsV3(),
+			"opentelekomcloud_gaussdb_mysql_instance_v3":          gaussdb.DataSourceGaussDBInstanceV3(),
 			"opentelekomcloud_evs_volumes_v2":                     evs.DataSourceEvsVolumesV2(),
+			"opentelekomcloud_hss_baseline_risks_v5":              hss.DataSourceBaselineRisks(),
 			"opentelekomcloud_hss_host_groups_v5":                 hss.DataSourceHostGroups(),
 			"opentelekomcloud_hss_quotas_v5":                      hss.DataSourceQuotas(),
 			"opentelekomcloud_hss_hosts_v5":                       hss.DataSourceHosts(),
 			"opentelekomcloud_hss_intrusion_events_v5":            hss.DataSourceEvents(),
+			"opentelekomcloud_hss_vulnerabilities_v5":             hss.DataSourceVulnerabilities(),
 			"opentelekomcloud_identity_agency_v3":                 iam.DataSourceIdentityAgencyV3(),
 			"opentelekomcloud_identity_auth_scope_v3":             iam.DataSourceIdentityAuthScopeV3(),
 			"opentelekomcloud_identity_credential_v3":             iam.DataSourceIdentityCredentialV3(),
 			"opentelekomcloud_identity_group_v3":                  iam.DataSourceIdentityGroupV3(),
+			"opentelekomcloud_identity_policy_document":           iam.DataSourceIdentityPolicyDocument(),
+			"opentelekomcloud_identity_policy_simulation_v3":      iam.DataSourceIdentityPolicySimulationV3(),
 			"opentelekomcloud_identity_project_v3":                iam.DataSourceIdentityProjectV3(),
 			"opentelekomcloud_identity_projects_v3":               iam.DataSourceIdentityProjectsV3(),
 			"opentelekomcloud_identity_role_v3":                   iam.DataSourceIdentityRoleV3(),
 			"opentelekomcloud_identity_role_custom_v3":            iam.DataSourceIdentityRoleCustomV3(),
 			"opentelekomcloud_identity_user_v3":                   iam.DataSourceIdentityUserV3(),
+			"opentelekomcloud_identity_users_v3":                  iam.DataSourceIdentityUsersV3(),
 			"opentelekomcloud_images_image_v2":                    ims.DataSourceImagesImageV2(),
 			"opentelekomcloud_kms_key_v1":                         kms.DataSourceKmsKeyV1(),
 			"opentelekomcloud_kms_data_key_v1":                    kms.DataSourceKmsDataKeyV1(),
 			"opentelekomcloud_kms_key_material_parameters_v1":     kms.DataSourceKmsImportParamsV1(),
+			"opentelekomcloud_kms_ciphertext_v1":                  kms.DataSourceKmsCiphertextV1(),
+			"opentelekomcloud_kms_secrets_v1":                     kms.DataSourceKmsSecretsV1(),
+			"opentelekomcloud_kms_signature_v1":                   kms.DataSourceKmsSignatureV1(),
 			"opentelekomcloud_lb_certificate_v3":                  elbv3.DataSourceCertificateV3(),
 			"opentelekomcloud_lb_flavor_v3":                       elbv3.DataSourceLBFlavorV3(),
 			"opentelekomcloud_lb_flavors_v3":                      elbv3.DataSourceLBFlavorsV3(),
 			"opentelekomcloud_lb_loadbalancer_v3":                 elbv3.DataSourceLoadBalancerV3(),
 			"opentelekomcloud_lb_listener_v3":                     elbv3.DataSourceListenerV3(),
+			"opentelekomcloud_lb_log_v3":                          elbv3.DataSourceLBLogV3(),
+			"opentelekomcloud_lb_members_v3":                      elbv3.DataSourceLBMembersV3(),
+			"opentelekomcloud_lb_monitor_v3":                      elbv3.DataSourceLBMonitorV3(),
+			"opentelekomcloud_lb_policies_v3":                     elbv3.DataSourceLBPoliciesV3(),
+			"opentelekomcloud_lb_pool_v3":                         elbv3.DataSourceLBPoolV3(),
+			"opentelekomcloud_lb_pools_v3":                        elbv3.DataSourceLBPoolsV3(),
 			"opentelekomcloud_lb_member_ids_v2":                   elbv2.DataSourceLBMemberIDsV2(),
+			"opentelekomcloud_lb_v2_to_v3_plan":                   elbv2.DataSourceLBV2ToV3Plan(),
 			"opentelekomcloud_nat_gateway_v2":                     nat.DataSourceNatGatewayV2(),
 			"opentelekomcloud_nat_dnat_rules_v2":                  nat.DataSourceDnatRulesV2(),
 			"opentelekomcloud_nat_snat_rules_v2":                  nat.DataSourceSnatRulesV2(),
+			"opentelekomcloud_nat_private_gateway_v3":             nat.DataSourceNatPrivateGatewayV3(),
+			"opentelekomcloud_nat_private_transit_ips_v3":         nat.DataSourceNatPrivateTransitIPsV3(),
+			"opentelekomcloud_network_path_analysis":              vpc.DataSourceNetworkPathAnalysis(),
 			"opentelekomcloud_networking_network_v2":              vpc.DataSourceNetworkingNetworkV2(),
 			"opentelekomcloud_networking_port_v2":                 vpc.DataSourceNetworkingPortV2(),
 			"opentelekomcloud_networking_port_ids_v2":             vpc.DataSourceNetworkingPortIDsV2(),
@@ -357,6 +382,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_sfs_turbo_share_v1":                 sfs.DataSourceSFSTurboShareV1(),
 			"opentelekomcloud_sdrs_domain_v1":                     sdrs.DataSourceSdrsDomainV1(),
 			"opentelekomcloud_vpc_eip_v1":                         vpc.DataSourceVPCEipV1(),
+			"opentelekomcloud_vpc_address_group_v3":               vpc.DataSourceVpcAddressGroupV3(),
 			"opentelekomcloud_vpc_v1":                             vpc.DataSourceVirtualPrivateCloudVpcV1(),
 			"opentelekomcloud_vpc_bandwidth":                      vpc.DataSourceBandWidth(),
 			"opentelekomcloud_vpc_bandwidth_v2":                   vpc.DataSourceBandWidthV2(),
@@ -375,7 +401,11 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_enterprise_vpn_connection_v5":       vpn.DataSourceEnterpriseConnection(),
 			"opentelekomcloud_enterprise_vpn_customer_gateway_v5": vpn.DataSourceEnterpriseCustomerGateway(),
 			"opentelekomcloud_enterprise_vpn_gateway_v5":          vpn.DataSourceEnterpriseVpnGateway(),
+			"opentelekomcloud_waf_dedicated_events_v1":            waf.DataSourceWafDedicatedEventsV1(),
+			"opentelekomcloud_waf_dedicated_policy_bundle_v1":     waf.DataSourceWafDedicatedPolicyBundleV1(),
+			"opentelekomcloud_waf_dedicated_qps_v1":               waf.DataSourceWafDedicatedQpsV1(),
 			"opentelekomcloud_waf_dedicated_reference_tables_v1":  waf.DataSourceWafDedicatedRefTablesV1(),
+			"opentelekomcloud_waf_dedicated_top_attacks_v1":       waf.DataSourceWafDedicatedTopAttacksV1(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -426,13 +456,19 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_compute_volume_attach_v2":                  ecs.ResourceComputeVolumeAttachV2(),
 			"opentelekomcloud_csbs_backup_v1":                            csbs.ResourceCSBSBackupV1(),
 			"opentelekomcloud_csbs_backup_policy_v1":                     csbs.ResourceCSBSBackupPolicyV1(),
+			"opentelekomcloud_csms_secret_v1":                            csms.ResourceCsmsSecretV1(),
+			"opentelekomcloud_csms_secret_version_v1":                    csms.ResourceCsmsSecretVersionV1(),
 			"opentelekomcloud_cts_event_notification_v3":                 cts.ResourceCTSEventNotificationV3(),
 			"opentelekomcloud_cts_tracker_v1":                            cts.ResourceCTSTrackerV1(),
 			"opentelekomcloud_cts_tracker_v3":                            cts.ResourceCTSTrackerV3(),
 			"opentelekomcloud_css_cluster_v1":                            css.ResourceCssClusterV1(),
 			"opentelekomcloud_css_cluster_restart_v1":                    css.ResourceCssClusterRestartV1(),
 			"opentelekomcloud_css_configuration_v1":                      css.ResourceCssConfigurationV1(),
+			"opentelekomcloud_css_index_template_v1":                     css.ResourceCssIndexTemplateV1(),
+			"opentelekomcloud_css_lifecycle_policy_v1":                   css.ResourceCssLifecyclePolicyV1(),
+			"opentelekomcloud_css_role_v1":                               css.ResourceCssRoleV1(),
 			"opentelekomcloud_css_snapshot_configuration_v1":             css.ResourceCssSnapshotConfigurationV1(),
+			"opentelekomcloud_css_user_v1":                               css.ResourceCssUserV1(),
 			"opentelekomcloud_direct_connect_v2":                         dcaas.ResourceDirectConnectV2(),
 			"opentelekomcloud_dc_endpoint_group_v2":                      dcaas.ResourceDCEndpointGroupV2(),
 			"opentelekomcloud_dc_virtual_interface_v2":                   dcaas.ResourceVirtualInterfaceV2(),
@@ -445,8 +481,13 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_dcs_instance_v2":                           dcs.ResourceDcsInstanceV2(),
 			"opentelekomcloud_ddm_instance_v1":                           ddm.ResourceDdmInstanceV1(),
 			"opentelekomcloud_ddm_schema_v1":                             ddm.ResourceDdmSchemaV1(),
+			"opentelekomcloud_dds_audit_log_policy_v3":                   dds.ResourceDdsAuditLogPolicyV3(),
 			"opentelekomcloud_dds_backup_v3":                             dds.ResourceDdsBackupV3(),
+			"opentelekomcloud_dds_database_role_v3":                      dds.ResourceDdsDatabaseRoleV3(),
+			"opentelekomcloud_dds_database_user_v3":                      dds.ResourceDdsDatabaseUserV3(),
 			"opentelekomcloud_dds_instance_v3":                           dds.ResourceDdsInstanceV3(),
+			"opentelekomcloud_dds_parameter_template_v3":                 dds.ResourceDdsParameterTemplateV3(),
+			"opentelekomcloud_dds_parameter_template_associate_v3":       dds.ResourceDdsParameterTemplateAssociateV3(),
 			"opentelekomcloud_deh_host_v1":                               deh.ResourceDeHHostV1(),
 			"opentelekomcloud_dis_stream_v2":                             dis.ResourceDisStreamV2(),
 			"opentelekomcloud_dis_app_v2":                                dis.ResourceDisAppV2(),
@@ -454,6 +495,9 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_dis_dump_task_v2":                          dis.ResourceDisDumpV2(),
 			"opentelekomcloud_dns_ptrrecord_v2":                          dns.ResourceDNSPtrRecordV2(),
 			"opentelekomcloud_dns_recordset_v2":                          dns.ResourceDNSRecordSetV2(),
+			"opentelekomcloud_dns_resolver_endpoint_v2":                  dns.ResourceDNSResolverEndpointV2(),
+			"opentelekomcloud_dns_resolver_rule_v2":                      dns.ResourceDNSResolverRuleV2(),
+			"opentelekomcloud_dns_zone_association_v2":                   dns.ResourceDNSZoneAssociationV2(),
 			"opentelekomcloud_dns_zone_v2":                               dns.ResourceDNSZoneV2(),
 			"opentelekomcloud_dms_consumer_group_v2":                     dms.ResourceDmsConsumerGroupV2(),
 			"opentelekomcloud_dms_instance_v1":                           dms.ResourceDmsInstancesV1(),
@@ -469,6 +513,9 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_dms_user_permission_v1":                    dms.ResourceDmsUsersPermissionV1(),
 			"opentelekomcloud_drs_task_v3":                               drs.ResourceDrsTaskV3(),
 			"opentelekomcloud_dws_cluster_v1":                            dws.ResourceDcsInstanceV1(),
+			"opentelekomcloud_dws_cluster_restore_v1":                    dws.ResourceDwsClusterRestoreV1(),
+			"opentelekomcloud_dws_snapshot_v1":                           dws.ResourceDwsSnapshotV1(),
+			"opentelekomcloud_dws_snapshot_policy_v1":                    dws.ResourceDwsSnapshotPolicyV1(),
 			"opentelekomcloud_ecs_instance_v1":                           ecs.ResourceEcsInstanceV1(),
 			"opentelekomcloud_er_association_v3":                         er.ResourceErAssociationV3(),
 			"opentelekomcloud_er_instance_v3":                            er.ResourceErInstanceV3(),
@@ -477,6 +524,10 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_er_static_route_v3":                        er.ResourceErStaticRouteV3(),
 			"opentelekomcloud_er_route_table_v3":                         er.ResourceErRouteTableV3(),
 			"opentelekomcloud_er_vpc_attachment_v3":                      er.ResourceErVpcAttachmentV3(),
+			"opentelekomcloud_er_vpn_attachment_v3":                      er.ResourceErVpnAttachmentV3(),
+			"opentelekomcloud_er_dc_attachment_v3":                       er.ResourceErDcAttachmentV3(),
+			"opentelekomcloud_er_attachment_acceptor_v3":                 er.ResourceErAttachmentAcceptorV3(),
+			"opentelekomcloud_er_share_v3":                               er.ResourceErShareV3(),
 			"opentelekomcloud_evs_volume_v3":                             evs.ResourceEvsStorageVolumeV3(),
 			"opentelekomcloud_fgs_async_invoke_config_v2":                fgs.ResourceAsyncInvokeConfigurationV2(),
 			"opentelekomcloud_fgs_event_v2":                              fgs.ResourceFgsEventV2(),
@@ -485,9 +536,15 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_fw_firewall_group_v2":                      fw.ResourceFWFirewallGroupV2(),
 			"opentelekomcloud_fw_policy_v2":                              fw.ResourceFWPolicyV2(),
 			"opentelekomcloud_fw_rule_v2":                                fw.ResourceFWRuleV2(),
+			"opentelekomcloud_gaussdb_mysql_account_v3":                  gaussdb.ResourceGaussDBAccountV3(),
+			"opentelekomcloud_gaussdb_mysql_database_v3":                 gaussdb.ResourceGaussDBDatabaseV3(),
 			"opentelekomcloud_gaussdb_mysql_instance_v3":                 gaussdb.ResourceGaussDBInstanceV3(),
+			"opentelekomcloud_gaussdb_mysql_parameter_template_v3":       gaussdb.ResourceGaussDBParameterTemplateV3(),
+			"opentelekomcloud_gaussdb_mysql_proxy_v3":                    gaussdb.ResourceGaussDBProxyV3(),
 			"opentelekomcloud_hss_host_group_v5":                         hss.ResourceHostGroup(),
 			"opentelekomcloud_hss_host_protection_v5":                    hss.ResourceHostProtection(),
+			"opentelekomcloud_hss_policy_group_v5":                       hss.ResourcePolicyGroup(),
+			"opentelekomcloud_hss_policy_group_binding_v5":               hss.ResourcePolicyGroupBinding(),
 			"opentelekomcloud_identity_acl_v3":                           iam.ResourceIdentityAclV3(),
 			"opentelekomcloud_identity_agency_v3":                        iam.ResourceIdentityAgencyV3(),
 			"opentelekomcloud_identity_credential_v3":                    iam.ResourceIdentityCredentialV3(),
@@ -505,6 +562,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_identity_role_assignment_v3":               iam.ResourceIdentityRoleAssignmentV3(),
 			"opentelekomcloud_identity_user_group_membership_v3":         iam.ResourceIdentityUserGroupMembershipV3(),
 			"opentelekomcloud_identity_user_v3":                          iam.ResourceIdentityUserV3(),
+			"opentelekomcloud_identity_virtual_mfa_device_v3":            iam.ResourceIdentityVirtualMfaDeviceV3(),
 			"opentelekomcloud_images_image_access_accept_v2":             ims.ResourceImagesImageAccessAcceptV2(),
 			"opentelekomcloud_images_image_access_v2":                    ims.ResourceImagesImageAccessV2(),
 			"opentelekomcloud_images_image_v2":                           ims.ResourceImagesImageV2(),
@@ -512,6 +570,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_ims_image_v2":                              ims.ResourceImsImageV2(),
 			"opentelekomcloud_ims_image_share_v1":                        ims.ResourceImsImageShareV1(),
 			"opentelekomcloud_ims_image_share_accept_v1":                 ims.ResourceImsImageShareAcceptV1(),
+			"opentelekomcloud_kms_alias_v1":                              kms.ResourceKmsAliasV1(),
 			"opentelekomcloud_kms_grant_v1":                              kms.ResourceKmsGrantV1(),
 			"opentelekomcloud_kms_key_v1":                                kms.ResourceKmsKeyV1(),
 			"opentelekomcloud_kms_key_material_v1":                       kms.ResourceKmsKeyMaterialV1(),
@@ -524,6 +583,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_lb_loadbalancer_v3":                        elbv3.ResourceLoadBalancerV3(),
 			"opentelekomcloud_lb_listener_v2":                            elbv2.ResourceListenerV2(),
 			"opentelekomcloud_lb_listener_v3":                            elbv3.ResourceListenerV3(),
+			"opentelekomcloud_lb_log_v3":                                 elbv3.ResourceLBLogV3(),
 			"opentelekomcloud_lb_member_v2":                              elbv2.ResourceMemberV2(),
 			"opentelekomcloud_lb_member_v3":                              elbv3.ResourceLBMemberV3(),
 			"opentelekomcloud_lb_monitor_v2":                             elbv2.ResourceMonitorV2(),
@@ -549,6 +609,11 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_nat_gateway_v2":                            nat.ResourceNatGatewayV2(),
 			"opentelekomcloud_nat_dnat_rule_v2":                          nat.ResourceNatDnatRuleV2(),
 			"opentelekomcloud_nat_snat_rule_v2":                          nat.ResourceNatSnatRuleV2(),
+			"opentelekomcloud_nat_snat_rules_v2":                         nat.ResourceNatSnatRulesV2(),
+			"opentelekomcloud_nat_private_gateway_v3":                    nat.ResourceNatPrivateGatewayV3(),
+			"opentelekomcloud_nat_private_transit_ip_v3":                 nat.ResourceNatPrivateTransitIPV3(),
+			"opentelekomcloud_nat_private_snat_rule_v3":                  nat.ResourceNatPrivateSnatRuleV3(),
+			"opentelekomcloud_nat_private_dnat_rule_v3":                  nat.ResourceNatPrivateDnatRuleV3(),
 			"opentelekomcloud_networking_floatingip_v2":                  vpc.ResourceNetworkingFloatingIPV2(),
 			"opentelekomcloud_networking_floatingip_associate_v2":        vpc.ResourceNetworkingFloatingIPAssociateV2(),
 			"opentelekomcloud_networking_network_v2":                     vpc.ResourceNetworkingNetworkV2(),
@@ -559,6 +624,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_networking_router_route_v2":                vpc.ResourceNetworkingRouterRouteV2(),
 			"opentelekomcloud_networking_secgroup_v2":                    vpc.ResourceNetworkingSecGroupV2(),
 			"opentelekomcloud_networking_secgroup_rule_v2":               vpc.ResourceNetworkingSecGroupRuleV2(),
+			"opentelekomcloud_networking_secgroup_rules_v2":              vpc.ResourceNetworkingSecGroupRulesV2(),
 			"opentelekomcloud_networking_subnet_v2":                      vpc.ResourceNetworkingSubnetV2(),
 			"opentelekomcloud_networking_vip_v2":                         vpc.ResourceNetworkingVIPV2(),
 			"opentelekomcloud_networking_vip_associate_v2":               vpc.ResourceNetworkingVIPAssociateV2(),
@@ -600,6 +666,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_vpc_bandwidth_associate_v2":                vpc.ResourceBandwidthAssociateV2(),
 			"opentelekomcloud_vpc_bandwidth_v2":                          vpc.ResourceBandwidthV2(),
 			"opentelekomcloud_vpc_eip_v1":                                vpc.ResourceVpcEIPV1(),
+			"opentelekomcloud_vpc_address_group_v3":                      vpc.ResourceVpcAddressGroupV3(),
 			"opentelekomcloud_vpc_v1":                                    vpc.ResourceVirtualPrivateCloudV1(),
 			"opentelekomcloud_vpc_peering_connection_v2":                 vpc.ResourceVpcPeeringConnectionV2(),
 			"opentelekomcloud_vpc_peering_connection_accepter_v2":        vpc.ResourceVpcPeeringConnectionAccepterV2(),
@@ -639,6 +706,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_waf_dedicated_instance_v1":                 waf.ResourceWafDedicatedInstance(),
 			"opentelekomcloud_waf_dedicated_domain_v1":                   waf.ResourceWafDedicatedDomain(),
 			"opentelekomcloud_waf_dedicated_policy_v1":                   waf.ResourceWafDedicatedPolicy(),
+			"opentelekomcloud_waf_dedicated_policy_bundle_v1":            waf.ResourceWafDedicatedPolicyBundleV1(),
 			"opentelekomcloud_waf_dedicated_certificate_v1":              waf.ResourceWafDedicatedCertificateV1(),
 			"opentelekomcloud_waf_dedicated_cc_rule_v1":                  waf.ResourceWafDedicatedCcRuleV1(),
 			"opentelekomcloud_waf_dedicated_anti_crawler_rule_v1":        waf.ResourceWafDedicatedAntiCrawlerRuleV1(),
@@ -0,0 +1,33 @@
+package csms
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	errCreationClient = "error creating OpenTelekomCloud CSMSv1 client: %w"
+	keyClientV1       = "csms-v1-client"
+
+	latestVersion  = "latest"
+	currentStage   = "SYSCURRENT"
+	previousStage  = "SYSPREVIOUS"
+	versionIDParts = 2
+)
+
+// formatTimestamp converts CSMS millisecond timestamps to RFC3339
+func formatTimestamp(ms int64) string {
+	if ms == 0 {
+		return ""
+	}
+	return time.UnixMilli(ms).UTC().Format(time.RFC3339)
+}
+
+func parseSecretVersionID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != versionIDParts || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid format specified for CSMS secret version, must be <secret_name>/<version_id>")
+	}
+	return parts[0], parts[1], nil
+}
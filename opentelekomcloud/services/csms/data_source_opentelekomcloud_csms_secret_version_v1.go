@@ -0,0 +1,103 @@
+package csms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceCsmsSecretVersionV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCsmsSecretVersionV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"secret_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"version_stage"},
+			},
+			"version_stage": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"secret_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_stages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCsmsSecretVersionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.CsmsV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	secretName := d.Get("secret_name").(string)
+	versionID := latestVersion
+	if v, ok := d.GetOk("version_id"); ok {
+		versionID = v.(string)
+	}
+	if v, ok := d.GetOk("version_stage"); ok {
+		stage, err := GetStage(client, secretName, v.(string))
+		if err != nil {
+			return fmterr.Errorf("error retrieving stage %s of CSMS secret %s: %s", v, secretName, err)
+		}
+		versionID = stage.VersionID
+	}
+
+	version, err := GetVersion(client, secretName, versionID)
+	if err != nil {
+		return fmterr.Errorf("error retrieving version %s of CSMS secret %s: %s", versionID, secretName, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", secretName, version.VersionMetadata.ID))
+
+	mErr := multierror.Append(nil,
+		d.Set("version_id", version.VersionMetadata.ID),
+		d.Set("secret_string", version.SecretString),
+		d.Set("version_stages", version.VersionMetadata.VersionStages),
+		d.Set("kms_key_id", version.VersionMetadata.KmsKeyID),
+		d.Set("create_time", formatTimestamp(version.VersionMetadata.CreateTime)),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CSMS secret version fields: %s", err)
+	}
+
+	return nil
+}
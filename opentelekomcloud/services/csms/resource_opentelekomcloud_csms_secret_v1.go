@@ -0,0 +1,244 @@
+package csms
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+var (
+	secretNameRegexp     = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+	rotationPeriodRegexp = regexp.MustCompile(`^[1-9][0-9]*[dh]$`)
+)
+
+func ResourceCsmsSecretV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCsmsSecretV1Create,
+		ReadContext:   resourceCsmsSecretV1Read,
+		UpdateContext: resourceCsmsSecretV1Update,
+		DeleteContext: resourceCsmsSecretV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(secretNameRegexp,
+					"name can contain only letters, digits, `.`, `_` and `-` and be up to 64 characters long"),
+			},
+			"secret_string": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 32768),
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"secret_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"COMMON", "RDS-FG"}, false),
+			},
+			"auto_rotation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"rotation_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"auto_rotation"},
+				ValidateFunc: validation.StringMatch(rotationPeriodRegexp,
+					"rotation period has to be set in hours or days, e.g. `12h` or `30d`"),
+			},
+			"recovery_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(7, 30),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_rotation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCsmsSecretV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.CsmsV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	opts := CreateSecretOpts{
+		Name:         d.Get("name").(string),
+		KmsKeyID:     d.Get("kms_key_id").(string),
+		Description:  d.Get("description").(string),
+		SecretString: d.Get("secret_string").(string),
+		SecretType:   d.Get("secret_type").(string),
+	}
+	if d.Get("auto_rotation").(bool) {
+		opts.AutoRotation = pointerto.Bool(true)
+		opts.RotationPeriod = d.Get("rotation_period").(string)
+	}
+
+	secret, err := CreateSecret(client, opts)
+	if err != nil {
+		return fmterr.Errorf("error creating CSMS secret: %s", err)
+	}
+	d.SetId(secret.Name)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceCsmsSecretV1Read(clientCtx, d, meta)
+}
+
+func resourceCsmsSecretV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.CsmsV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	secret, err := GetSecret(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "CSMS secret")
+	}
+
+	latest, err := GetVersion(client, d.Id(), latestVersion)
+	if err != nil {
+		return fmterr.Errorf("error retrieving latest version of CSMS secret %s: %s", d.Id(), err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", secret.Name),
+		d.Set("kms_key_id", secret.KmsKeyID),
+		d.Set("description", secret.Description),
+		d.Set("secret_type", secret.SecretType),
+		d.Set("auto_rotation", secret.AutoRotation),
+		d.Set("rotation_period", secret.RotationPeriod),
+		d.Set("state", secret.State),
+		d.Set("latest_version_id", latest.VersionMetadata.ID),
+		d.Set("create_time", formatTimestamp(secret.CreateTime)),
+		d.Set("update_time", formatTimestamp(secret.UpdateTime)),
+		d.Set("next_rotation_time", formatTimestamp(secret.NextRotationTime)),
+		d.Set("region", config.GetRegion(d)),
+	)
+	// value is tracked only when it's managed by this resource, versions may be managed separately
+	if _, ok := d.GetOk("secret_string"); ok {
+		mErr = multierror.Append(mErr, d.Set("secret_string", latest.SecretString))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CSMS secret fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCsmsSecretV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.CsmsV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if d.HasChanges("kms_key_id", "description", "auto_rotation", "rotation_period") {
+		description := d.Get("description").(string)
+		opts := UpdateSecretOpts{
+			KmsKeyID:     d.Get("kms_key_id").(string),
+			Description:  &description,
+			AutoRotation: pointerto.Bool(d.Get("auto_rotation").(bool)),
+		}
+		if d.Get("auto_rotation").(bool) {
+			opts.RotationPeriod = d.Get("rotation_period").(string)
+		}
+		if err := UpdateSecret(client, d.Id(), opts); err != nil {
+			return fmterr.Errorf("error updating CSMS secret %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("secret_string") {
+		// new version automatically gets SYSCURRENT stage, the old one becomes SYSPREVIOUS
+		_, err := CreateVersion(client, d.Id(), CreateVersionOpts{
+			SecretString: d.Get("secret_string").(string),
+		})
+		if err != nil {
+			return fmterr.Errorf("error creating new version of CSMS secret %s: %s", d.Id(), err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceCsmsSecretV1Read(clientCtx, d, meta)
+}
+
+func resourceCsmsSecretV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.CsmsV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if days, ok := d.GetOk("recovery_window_in_days"); ok {
+		err = ScheduleSecretDeletion(client, d.Id(), DeleteSecretOpts{
+			RecoveryWindowInDays: days.(int),
+		})
+	} else {
+		err = DeleteSecret(client, d.Id())
+	}
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "CSMS secret")
+	}
+	return nil
+}
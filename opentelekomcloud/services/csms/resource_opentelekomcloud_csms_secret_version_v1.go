@@ -0,0 +1,234 @@
+package csms
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCsmsSecretVersionV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCsmsSecretVersionV1Create,
+		ReadContext:   resourceCsmsSecretVersionV1Read,
+		UpdateContext: resourceCsmsSecretVersionV1Update,
+		DeleteContext: resourceCsmsSecretVersionV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCsmsSecretVersionV1Import,
+		},
+
+		CustomizeDiff: validateSystemStages,
+
+		Schema: map[string]*schema.Schema{
+			"secret_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(secretNameRegexp,
+					"name can contain only letters, digits, `.`, `_` and `-` and be up to 64 characters long"),
+			},
+			"secret_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 32768),
+			},
+			"version_stages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCsmsSecretVersionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.CsmsV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	secretName := d.Get("secret_name").(string)
+	version, err := CreateVersion(client, secretName, CreateVersionOpts{
+		SecretString:  d.Get("secret_string").(string),
+		VersionStages: common.ExpandToStringSlice(d.Get("version_stages").(*schema.Set).List()),
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating CSMS secret version: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", secretName, version.ID))
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceCsmsSecretVersionV1Read(clientCtx, d, meta)
+}
+
+func resourceCsmsSecretVersionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.CsmsV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	secretName, versionID, err := parseSecretVersionID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	version, err := GetVersion(client, secretName, versionID)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "CSMS secret version")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("secret_name", secretName),
+		d.Set("secret_string", version.SecretString),
+		d.Set("version_stages", version.VersionMetadata.VersionStages),
+		d.Set("version_id", version.VersionMetadata.ID),
+		d.Set("kms_key_id", version.VersionMetadata.KmsKeyID),
+		d.Set("create_time", formatTimestamp(version.VersionMetadata.CreateTime)),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CSMS secret version fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCsmsSecretVersionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.CsmsV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if d.HasChange("version_stages") {
+		secretName, versionID, err := parseSecretVersionID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		oldRaw, newRaw := d.GetChange("version_stages")
+		oldStages, newStages := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+		for _, stage := range newStages.Difference(oldStages).List() {
+			err := UpdateStage(client, secretName, stage.(string), UpdateStageOpts{VersionID: versionID})
+			if err != nil {
+				return fmterr.Errorf("error moving stage %s to CSMS secret version %s: %s", stage, d.Id(), err)
+			}
+		}
+		for _, stage := range oldStages.Difference(newStages).List() {
+			if err := removeVersionStage(client, secretName, versionID, stage.(string)); err != nil {
+				return fmterr.Errorf("error removing stage %s from CSMS secret version %s: %s", stage, d.Id(), err)
+			}
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceCsmsSecretVersionV1Read(clientCtx, d, meta)
+}
+
+// CSMS doesn't allow deleting single versions, they are removed together with the secret
+// or dropped by the service once the version limit is reached.
+func resourceCsmsSecretVersionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.CsmsV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	secretName, versionID, err := parseSecretVersionID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, stage := range d.Get("version_stages").(*schema.Set).List() {
+		if err := removeVersionStage(client, secretName, versionID, stage.(string)); err != nil {
+			return common.CheckDeletedDiag(d, err, "CSMS secret version")
+		}
+	}
+	log.Printf("[DEBUG] CSMS secret version %s can't be deleted, removing it from state only", d.Id())
+
+	return nil
+}
+
+func resourceCsmsSecretVersionV1Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseSecretVersionID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// validateSystemStages rejects removal of the system stages, which can only be moved to other versions
+func validateSystemStages(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("version_stages") || !d.NewValueKnown("version_stages") {
+		return nil
+	}
+	oldRaw, newRaw := d.GetChange("version_stages")
+	oldStages, newStages := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+	for _, stage := range []string{currentStage, previousStage} {
+		if oldStages.Contains(stage) && !newStages.Contains(stage) {
+			return fmt.Errorf("%s stage can't be removed from the version, attach it to another version instead", stage)
+		}
+	}
+	return nil
+}
+
+// removeVersionStage deletes custom stage if it is still attached to the version,
+// system stages are managed by the service and can only be moved to other versions
+func removeVersionStage(client *golangsdk.ServiceClient, secretName, versionID, stage string) error {
+	if stage == currentStage || stage == previousStage {
+		return nil
+	}
+	current, err := GetStage(client, secretName, stage)
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil
+		}
+		return err
+	}
+	if current.VersionID != versionID {
+		return nil
+	}
+	return DeleteStage(client, secretName, stage)
+}
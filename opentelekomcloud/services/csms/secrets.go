@@ -0,0 +1,203 @@
+package csms
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Cloud Secret Management Service is not covered by gophertelekomcloud yet.
+
+type CreateSecretOpts struct {
+	Name           string `json:"name" required:"true"`
+	KmsKeyID       string `json:"kms_key_id,omitempty"`
+	Description    string `json:"description,omitempty"`
+	SecretString   string `json:"secret_string,omitempty"`
+	SecretType     string `json:"secret_type,omitempty"`
+	AutoRotation   *bool  `json:"auto_rotation,omitempty"`
+	RotationPeriod string `json:"rotation_period,omitempty"`
+}
+
+type UpdateSecretOpts struct {
+	KmsKeyID       string  `json:"kms_key_id,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	AutoRotation   *bool   `json:"auto_rotation,omitempty"`
+	RotationPeriod string  `json:"rotation_period,omitempty"`
+}
+
+type DeleteSecretOpts struct {
+	RecoveryWindowInDays int `json:"recovery_window_in_days" required:"true"`
+}
+
+type CreateVersionOpts struct {
+	SecretString  string   `json:"secret_string" required:"true"`
+	VersionStages []string `json:"version_stages,omitempty"`
+}
+
+type UpdateStageOpts struct {
+	VersionID string `json:"version_id" required:"true"`
+}
+
+type Secret struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	State               string `json:"state"`
+	KmsKeyID            string `json:"kms_key_id"`
+	Description         string `json:"description"`
+	SecretType          string `json:"secret_type"`
+	AutoRotation        bool   `json:"auto_rotation"`
+	RotationPeriod      string `json:"rotation_period"`
+	CreateTime          int64  `json:"create_time"`
+	UpdateTime          int64  `json:"update_time"`
+	NextRotationTime    int64  `json:"next_rotation_time"`
+	ScheduledDeleteTime int64  `json:"scheduled_delete_time"`
+}
+
+type VersionMetadata struct {
+	ID            string   `json:"id"`
+	SecretName    string   `json:"secret_name"`
+	KmsKeyID      string   `json:"kms_key_id"`
+	VersionStages []string `json:"version_stages"`
+	CreateTime    int64    `json:"create_time"`
+	ExpireTime    int64    `json:"expire_time"`
+}
+
+type Version struct {
+	VersionMetadata VersionMetadata `json:"version_metadata"`
+	SecretString    string          `json:"secret_string"`
+}
+
+type Stage struct {
+	Name       string `json:"name"`
+	SecretName string `json:"secret_name"`
+	VersionID  string `json:"version_id"`
+	UpdateTime int64  `json:"update_time"`
+}
+
+func CreateSecret(client *golangsdk.ServiceClient, opts CreateSecretOpts) (*Secret, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v1/{project_id}/secrets
+	var res struct {
+		Secret Secret `json:"secret"`
+	}
+	_, err = client.Post(client.ServiceURL("secrets"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Secret, nil
+}
+
+func GetSecret(client *golangsdk.ServiceClient, name string) (*Secret, error) {
+	// GET /v1/{project_id}/secrets/{secret_name}
+	var res struct {
+		Secret Secret `json:"secret"`
+	}
+	_, err := client.Get(client.ServiceURL("secrets", name), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Secret, nil
+}
+
+func UpdateSecret(client *golangsdk.ServiceClient, name string, opts UpdateSecretOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v1/{project_id}/secrets/{secret_name}
+	_, err = client.Put(client.ServiceURL("secrets", name), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeleteSecret(client *golangsdk.ServiceClient, name string) error {
+	// DELETE /v1/{project_id}/secrets/{secret_name}
+	_, err := client.Delete(client.ServiceURL("secrets", name), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func ScheduleSecretDeletion(client *golangsdk.ServiceClient, name string, opts DeleteSecretOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// POST /v1/{project_id}/secrets/{secret_name}/scheduled-deleted-tasks/create
+	_, err = client.Post(client.ServiceURL("secrets", name, "scheduled-deleted-tasks", "create"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func CreateVersion(client *golangsdk.ServiceClient, name string, opts CreateVersionOpts) (*VersionMetadata, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v1/{project_id}/secrets/{secret_name}/versions
+	var res struct {
+		VersionMetadata VersionMetadata `json:"version_metadata"`
+	}
+	_, err = client.Post(client.ServiceURL("secrets", name, "versions"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.VersionMetadata, nil
+}
+
+// GetVersion returns the secret value of the version, `latest` can be used as versionID
+func GetVersion(client *golangsdk.ServiceClient, name, versionID string) (*Version, error) {
+	// GET /v1/{project_id}/secrets/{secret_name}/versions/{version_id}
+	var res struct {
+		Version Version `json:"version"`
+	}
+	_, err := client.Get(client.ServiceURL("secrets", name, "versions", versionID), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Version, nil
+}
+
+func GetStage(client *golangsdk.ServiceClient, name, stage string) (*Stage, error) {
+	// GET /v1/{project_id}/secrets/{secret_name}/stages/{stage_name}
+	var res struct {
+		Stage Stage `json:"stage"`
+	}
+	_, err := client.Get(client.ServiceURL("secrets", name, "stages", stage), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Stage, nil
+}
+
+func UpdateStage(client *golangsdk.ServiceClient, name, stage string, opts UpdateStageOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v1/{project_id}/secrets/{secret_name}/stages/{stage_name}
+	_, err = client.Put(client.ServiceURL("secrets", name, "stages", stage), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeleteStage(client *golangsdk.ServiceClient, name, stage string) error {
+	// DELETE /v1/{project_id}/secrets/{secret_name}/stages/{stage_name}
+	_, err := client.Delete(client.ServiceURL("secrets", name, "stages", stage), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
@@ -0,0 +1,373 @@
+package css
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/clusters"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	esClientError = `error creating CSS cluster HTTP client: %w`
+	adminPassEnv  = "OS_CSS_ADMIN_PASS"
+)
+
+// esClient talks directly to the Elasticsearch/OpenSearch HTTP API of a CSS cluster.
+type esClient struct {
+	http     *http.Client
+	baseURL  string
+	username string
+	password string
+
+	distribution string
+}
+
+// esConnectionSchema returns the arguments used to reach the cluster endpoint.
+// Clusters with `enable_authority` require `admin_pass` of the cluster administrator.
+func esConnectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"cluster_id", "endpoint"},
+			Description:  `ID of the CSS cluster, the endpoint and the security mode are taken from the cluster.`,
+		},
+		"endpoint": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"cluster_id", "endpoint"},
+			Description:  `Cluster endpoint, e.g. the endpoint attribute of the CSS cluster.`,
+		},
+		"admin_user": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "admin",
+			Description: `Username of the cluster administrator.`,
+		},
+		"admin_pass": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			DefaultFunc: schema.EnvDefaultFunc(adminPassEnv, nil),
+			Description: `Password of the cluster administrator.`,
+		},
+		"insecure": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: `Skip verification of the cluster certificate.`,
+		},
+		"ca_cert": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `PEM-encoded CA certificate of the cluster.`,
+		},
+	}
+}
+
+func newEsClient(ctx context.Context, d *schema.ResourceData, meta interface{}) (*esClient, error) {
+	endpoint := d.Get("endpoint").(string)
+	username := d.Get("admin_user").(string)
+	password := d.Get("admin_pass").(string)
+
+	if clusterID := d.Get("cluster_id").(string); clusterID != "" {
+		config := meta.(*cfg.Config)
+		client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+			return config.CssV1Client(config.GetRegion(d))
+		})
+		if err != nil {
+			return nil, fmt.Errorf(clientError, err)
+		}
+		cluster, err := clusters.Get(client, clusterID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting CSS cluster %s: %w", clusterID, err)
+		}
+		if err := d.Set("endpoint", cluster.Endpoint); err != nil {
+			return nil, err
+		}
+
+		endpoint = strings.TrimSpace(strings.Split(cluster.Endpoint, ",")[0])
+		if cluster.HttpsEnabled {
+			endpoint = "https://" + endpoint
+		} else {
+			endpoint = "http://" + endpoint
+		}
+		switch {
+		case !cluster.AuthorityEnabled:
+			username, password = "", ""
+		case password == "":
+			return nil, fmt.Errorf("admin_pass is required, CSS cluster %s has enable_authority set", clusterID)
+		}
+	}
+
+	endpoint = strings.TrimSpace(strings.Split(endpoint, ",")[0])
+	if endpoint == "" {
+		return nil, fmt.Errorf("endpoint is empty")
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	if _, err := url.Parse(endpoint); err != nil {
+		return nil, fmt.Errorf("invalid endpoint %s: %w", endpoint, err)
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: d.Get("insecure").(bool), // nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	}
+	if caCert := d.Get("ca_cert").(string); caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("failed to parse ca_cert")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.TLSClientConfig = tlsConfig
+
+	client := &esClient{
+		http:     &http.Client{Transport: transport},
+		baseURL:  strings.TrimSuffix(endpoint, "/"),
+		username: username,
+		password: password,
+	}
+	return client, nil
+}
+
+// importCssClusterObject imports the objects using `<cluster_id>/<name>` ID,
+// password of the cluster administrator is taken from OS_CSS_ADMIN_PASS as it can't be read from the cluster
+func importCssClusterObject(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <cluster_id>/<name>")
+	}
+	d.SetId(parts[1])
+
+	mErr := multierror.Append(nil,
+		d.Set("cluster_id", parts[0]),
+		d.Set("name", parts[1]),
+		d.Set("admin_user", "admin"),
+		d.Set("insecure", false),
+	)
+	if pass := os.Getenv(adminPassEnv); pass != "" {
+		mErr = multierror.Append(mErr, d.Set("admin_pass", pass))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// do sends the request and returns the response body, 404 responses are returned as golangsdk.ErrDefault404
+func (c *esClient) do(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+"/"+strings.TrimPrefix(path, "/"), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, golangsdk.ErrDefault404{
+			ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+				Method:   method,
+				URL:      req.URL.String(),
+				Actual:   resp.StatusCode,
+				Body:     respBody,
+				Expected: []int{200},
+			},
+		}
+	case resp.StatusCode >= 300:
+		return nil, fmt.Errorf("%s %s returned %d: %s", method, req.URL.Path, resp.StatusCode, respBody)
+	}
+	return respBody, nil
+}
+
+func (c *esClient) getJSON(ctx context.Context, path string, out interface{}) error {
+	b, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// isOpenSearch reports if the cluster is running OpenSearch, which uses `_plugins` instead of `_opendistro` APIs
+func (c *esClient) isOpenSearch(ctx context.Context) (bool, error) {
+	if c.distribution == "" {
+		info := struct {
+			Version struct {
+				Distribution string `json:"distribution"`
+			} `json:"version"`
+		}{}
+		if err := c.getJSON(ctx, "/", &info); err != nil {
+			return false, err
+		}
+		c.distribution = info.Version.Distribution
+		if c.distribution == "" {
+			c.distribution = "elasticsearch"
+		}
+	}
+	return c.distribution == "opensearch", nil
+}
+
+func (c *esClient) pluginPath(ctx context.Context, plugin string) (string, error) {
+	openSearch, err := c.isOpenSearch(ctx)
+	if err != nil {
+		return "", err
+	}
+	if openSearch {
+		return "_plugins/" + plugin, nil
+	}
+	return "_opendistro/" + plugin, nil
+}
+
+// esJSONSchema returns the JSON document argument compared with common.CompareJsonTemplateAreEquivalent
+func esJSONSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsJSON,
+		StateFunc: func(v interface{}) string {
+			jsonString, _ := structure.NormalizeJsonString(v)
+			return jsonString
+		},
+		DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+			equal, _ := common.CompareJsonTemplateAreEquivalent(old, new)
+			return equal
+		},
+		Description: description,
+	}
+}
+
+// liveBody returns the JSON to be stored in the state: the configured document is kept
+// while the cluster document contains it, otherwise the cluster document is returned to show the drift.
+func liveBody(configured string, live interface{}) (string, error) {
+	liveJSON, err := json.Marshal(live)
+	if err != nil {
+		return "", err
+	}
+	if configured != "" {
+		var want interface{}
+		if err := json.Unmarshal([]byte(configured), &want); err == nil && esJSONContains(live, want) {
+			return configured, nil
+		}
+	}
+	return string(liveJSON), nil
+}
+
+// esJSONContains checks that every value of `want` is present in `live`.
+// Scalars are compared by their string form as the cluster returns most settings as strings,
+// `settings` objects are compared flattened, ignoring the `index.` prefix.
+func esJSONContains(live, want interface{}) bool {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, wv := range w {
+			lv, ok := l[k]
+			if !ok {
+				return false
+			}
+			if k == "settings" {
+				wv, lv = flattenEsSettings(wv), flattenEsSettings(lv)
+			}
+			if !esJSONContains(lv, wv) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(w) {
+			return false
+		}
+		for i := range w {
+			if !esJSONContains(l[i], w[i]) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return live == nil
+	default:
+		return live != nil && fmt.Sprint(live) == fmt.Sprint(w)
+	}
+}
+
+func flattenEsSettings(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	flat := make(map[string]interface{})
+	var walk func(prefix string, m map[string]interface{})
+	walk = func(prefix string, m map[string]interface{}) {
+		for k, v := range m {
+			key := prefix + k
+			if nested, ok := v.(map[string]interface{}); ok {
+				walk(key+".", nested)
+				continue
+			}
+			flat[strings.TrimPrefix(key, "index.")] = v
+		}
+	}
+	walk("", m)
+	return flat
+}
+
+// dropKeys returns a copy of the document without the server-managed keys
+func dropKeys(v map[string]interface{}, keys ...string) map[string]interface{} {
+	res := make(map[string]interface{}, len(v))
+	for k, val := range v {
+		res[k] = val
+	}
+	for _, k := range keys {
+		delete(res, k)
+	}
+	return res
+}
@@ -0,0 +1,128 @@
+package css
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCssIndexTemplateV1() *schema.Resource {
+	s := esConnectionSchema()
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: `Name of the index template.`,
+	}
+	s["composable"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Description: `Whether the template is managed with the composable _index_template API instead of the legacy _template one.`,
+	}
+	s["body"] = esJSONSchema(`JSON definition of the index template.`)
+
+	return &schema.Resource{
+		CreateContext: resourceCssIndexTemplateV1Put,
+		ReadContext:   resourceCssIndexTemplateV1Read,
+		UpdateContext: resourceCssIndexTemplateV1Put,
+		DeleteContext: resourceCssIndexTemplateV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCssClusterObject,
+		},
+
+		Schema: s,
+	}
+}
+
+func cssIndexTemplatePath(d *schema.ResourceData) string {
+	if d.Get("composable").(bool) {
+		return "_index_template/" + d.Get("name").(string)
+	}
+	return "_template/" + d.Get("name").(string)
+}
+
+func resourceCssIndexTemplateV1Put(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+
+	var body interface{}
+	if err := json.Unmarshal([]byte(d.Get("body").(string)), &body); err != nil {
+		return fmterr.Errorf("error parsing index template body: %w", err)
+	}
+	if _, err := client.do(ctx, http.MethodPut, cssIndexTemplatePath(d), body); err != nil {
+		return fmterr.Errorf("error putting CSS index template: %w", err)
+	}
+	d.SetId(d.Get("name").(string))
+
+	return resourceCssIndexTemplateV1Read(ctx, d, meta)
+}
+
+func resourceCssIndexTemplateV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+
+	var live interface{}
+	if d.Get("composable").(bool) {
+		resp := struct {
+			IndexTemplates []struct {
+				Name          string                 `json:"name"`
+				IndexTemplate map[string]interface{} `json:"index_template"`
+			} `json:"index_templates"`
+		}{}
+		if err := client.getJSON(ctx, cssIndexTemplatePath(d), &resp); err != nil {
+			return common.CheckDeletedDiag(d, err, "CSS index template")
+		}
+		for _, t := range resp.IndexTemplates {
+			if t.Name == d.Id() {
+				live = t.IndexTemplate
+			}
+		}
+	} else {
+		resp := make(map[string]interface{})
+		if err := client.getJSON(ctx, cssIndexTemplatePath(d), &resp); err != nil {
+			return common.CheckDeletedDiag(d, err, "CSS index template")
+		}
+		live = resp[d.Id()]
+	}
+	if live == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "CSS index template")
+	}
+
+	body, err := liveBody(d.Get("body").(string), live)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error flattening index template: %w", err))
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", d.Id()),
+		d.Set("body", body),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCssIndexTemplateV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+
+	if _, err := client.do(ctx, http.MethodDelete, cssIndexTemplatePath(d), nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CSS index template")
+	}
+	return nil
+}
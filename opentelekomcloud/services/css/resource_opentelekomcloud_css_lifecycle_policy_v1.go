@@ -0,0 +1,179 @@
+package css
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCssLifecyclePolicyV1() *schema.Resource {
+	s := esConnectionSchema()
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: `Name of the lifecycle policy.`,
+	}
+	s["type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "ism",
+		ValidateFunc: validation.StringInSlice([]string{"ism", "ilm"}, false),
+		Description:  `Lifecycle management API: ism (Index State Management) or ilm (Index Lifecycle Management).`,
+	}
+	s["body"] = esJSONSchema(`JSON definition of the policy, the content of the policy object.`)
+
+	return &schema.Resource{
+		CreateContext: resourceCssLifecyclePolicyV1Create,
+		ReadContext:   resourceCssLifecyclePolicyV1Read,
+		UpdateContext: resourceCssLifecyclePolicyV1Update,
+		DeleteContext: resourceCssLifecyclePolicyV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCssClusterObject,
+		},
+
+		Schema: s,
+	}
+}
+
+type ismPolicy struct {
+	SeqNo       int                    `json:"_seq_no"`
+	PrimaryTerm int                    `json:"_primary_term"`
+	Policy      map[string]interface{} `json:"policy"`
+}
+
+func cssLifecyclePolicyPath(ctx context.Context, client *esClient, d *schema.ResourceData) (string, error) {
+	name := d.Get("name").(string)
+	if d.Get("type").(string) == "ilm" {
+		return "_ilm/policy/" + name, nil
+	}
+	prefix, err := client.pluginPath(ctx, "_ism")
+	if err != nil {
+		return "", err
+	}
+	return prefix + "/policies/" + name, nil
+}
+
+func putCssLifecyclePolicy(ctx context.Context, client *esClient, d *schema.ResourceData, path string) error {
+	var policy interface{}
+	if err := json.Unmarshal([]byte(d.Get("body").(string)), &policy); err != nil {
+		return fmt.Errorf("error parsing policy body: %w", err)
+	}
+	_, err := client.do(ctx, http.MethodPut, path, map[string]interface{}{"policy": policy})
+	return err
+}
+
+func resourceCssLifecyclePolicyV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+	path, err := cssLifecyclePolicyPath(ctx, client, d)
+	if err != nil {
+		return fmterr.Errorf("error getting cluster information: %w", err)
+	}
+
+	if err := putCssLifecyclePolicy(ctx, client, d, path); err != nil {
+		return fmterr.Errorf("error creating CSS lifecycle policy: %w", err)
+	}
+	d.SetId(d.Get("name").(string))
+
+	return resourceCssLifecyclePolicyV1Read(ctx, d, meta)
+}
+
+func resourceCssLifecyclePolicyV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+	path, err := cssLifecyclePolicyPath(ctx, client, d)
+	if err != nil {
+		return fmterr.Errorf("error getting cluster information: %w", err)
+	}
+
+	var live map[string]interface{}
+	if d.Get("type").(string) == "ilm" {
+		resp := make(map[string]struct {
+			Policy map[string]interface{} `json:"policy"`
+		})
+		if err := client.getJSON(ctx, path, &resp); err != nil {
+			return common.CheckDeletedDiag(d, err, "CSS lifecycle policy")
+		}
+		live = resp[d.Id()].Policy
+	} else {
+		resp := ismPolicy{}
+		if err := client.getJSON(ctx, path, &resp); err != nil {
+			return common.CheckDeletedDiag(d, err, "CSS lifecycle policy")
+		}
+		live = dropKeys(resp.Policy, "policy_id", "last_updated_time", "schema_version")
+	}
+	if live == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "CSS lifecycle policy")
+	}
+
+	body, err := liveBody(d.Get("body").(string), live)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error flattening lifecycle policy: %w", err))
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", d.Id()),
+		d.Set("body", body),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCssLifecyclePolicyV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+	path, err := cssLifecyclePolicyPath(ctx, client, d)
+	if err != nil {
+		return fmterr.Errorf("error getting cluster information: %w", err)
+	}
+
+	if d.HasChange("body") {
+		if d.Get("type").(string) == "ism" {
+			// ISM policies are updated with optimistic concurrency control
+			current := ismPolicy{}
+			if err := client.getJSON(ctx, path, &current); err != nil {
+				return fmterr.Errorf("error retrieving CSS lifecycle policy: %w", err)
+			}
+			path = fmt.Sprintf("%s?if_seq_no=%d&if_primary_term=%d", path, current.SeqNo, current.PrimaryTerm)
+		}
+		if err := putCssLifecyclePolicy(ctx, client, d, path); err != nil {
+			return fmterr.Errorf("error updating CSS lifecycle policy: %w", err)
+		}
+	}
+
+	return resourceCssLifecyclePolicyV1Read(ctx, d, meta)
+}
+
+func resourceCssLifecyclePolicyV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+	path, err := cssLifecyclePolicyPath(ctx, client, d)
+	if err != nil {
+		return fmterr.Errorf("error getting cluster information: %w", err)
+	}
+
+	if _, err := client.do(ctx, http.MethodDelete, path, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CSS lifecycle policy")
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package css
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCssRoleV1() *schema.Resource {
+	s := esConnectionSchema()
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: `Name of the security role.`,
+	}
+	s["body"] = esJSONSchema(`JSON definition of the role: cluster, index and tenant permissions.`)
+
+	return &schema.Resource{
+		CreateContext: resourceCssRoleV1Put,
+		ReadContext:   resourceCssRoleV1Read,
+		UpdateContext: resourceCssRoleV1Put,
+		DeleteContext: resourceCssRoleV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCssClusterObject,
+		},
+
+		Schema: s,
+	}
+}
+
+func cssSecurityPath(ctx context.Context, client *esClient, kind, name string) (string, error) {
+	prefix, err := client.pluginPath(ctx, "_security")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/api/%s/%s", prefix, kind, name), nil
+}
+
+func resourceCssRoleV1Put(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+	path, err := cssSecurityPath(ctx, client, "roles", d.Get("name").(string))
+	if err != nil {
+		return fmterr.Errorf("error getting cluster information: %w", err)
+	}
+
+	var body interface{}
+	if err := json.Unmarshal([]byte(d.Get("body").(string)), &body); err != nil {
+		return fmterr.Errorf("error parsing role body: %w", err)
+	}
+	if _, err := client.do(ctx, http.MethodPut, path, body); err != nil {
+		return fmterr.Errorf("error putting CSS role: %w", err)
+	}
+	d.SetId(d.Get("name").(string))
+
+	return resourceCssRoleV1Read(ctx, d, meta)
+}
+
+func resourceCssRoleV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+	path, err := cssSecurityPath(ctx, client, "roles", d.Id())
+	if err != nil {
+		return fmterr.Errorf("error getting cluster information: %w", err)
+	}
+
+	resp := make(map[string]map[string]interface{})
+	if err := client.getJSON(ctx, path, &resp); err != nil {
+		return common.CheckDeletedDiag(d, err, "CSS role")
+	}
+	role, ok := resp[d.Id()]
+	if !ok {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "CSS role")
+	}
+
+	body, err := liveBody(d.Get("body").(string), dropKeys(role, "reserved", "hidden", "static"))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error flattening role: %w", err))
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", d.Id()),
+		d.Set("body", body),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCssRoleV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+	path, err := cssSecurityPath(ctx, client, "roles", d.Id())
+	if err != nil {
+		return fmterr.Errorf("error getting cluster information: %w", err)
+	}
+
+	if _, err := client.do(ctx, http.MethodDelete, path, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CSS role")
+	}
+	return nil
+}
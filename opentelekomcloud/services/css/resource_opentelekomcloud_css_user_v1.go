@@ -0,0 +1,153 @@
+package css
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCssUserV1() *schema.Resource {
+	s := esConnectionSchema()
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: `Name of the internal user.`,
+	}
+	s["password"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringLenBetween(8, 128),
+		Description:  `Password of the internal user.`,
+	}
+	s["roles"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: `Security roles assigned to the user.`,
+	}
+	s["backend_roles"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: `Backend roles of the user.`,
+	}
+	s["attributes"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: `Custom attributes of the user.`,
+	}
+	s["description"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceCssUserV1Put,
+		ReadContext:   resourceCssUserV1Read,
+		UpdateContext: resourceCssUserV1Put,
+		DeleteContext: resourceCssUserV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCssClusterObject,
+		},
+
+		Schema: s,
+	}
+}
+
+type internalUser struct {
+	Password      string            `json:"password,omitempty"`
+	Roles         []string          `json:"opendistro_security_roles"`
+	BackendRoles  []string          `json:"backend_roles"`
+	Attributes    map[string]string `json:"attributes"`
+	Description   string            `json:"description,omitempty"`
+	SecurityRoles []string          `json:"security_roles,omitempty"`
+}
+
+func resourceCssUserV1Put(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+	path, err := cssSecurityPath(ctx, client, "internalusers", d.Get("name").(string))
+	if err != nil {
+		return fmterr.Errorf("error getting cluster information: %w", err)
+	}
+
+	user := internalUser{
+		Password:     d.Get("password").(string),
+		Roles:        common.ExpandToStringListBySet(d.Get("roles").(*schema.Set)),
+		BackendRoles: common.ExpandToStringListBySet(d.Get("backend_roles").(*schema.Set)),
+		Attributes:   make(map[string]string),
+		Description:  d.Get("description").(string),
+	}
+	for k, v := range d.Get("attributes").(map[string]interface{}) {
+		user.Attributes[k] = v.(string)
+	}
+	if _, err := client.do(ctx, http.MethodPut, path, user); err != nil {
+		return fmterr.Errorf("error putting CSS user: %w", err)
+	}
+	d.SetId(d.Get("name").(string))
+
+	return resourceCssUserV1Read(ctx, d, meta)
+}
+
+func resourceCssUserV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+	path, err := cssSecurityPath(ctx, client, "internalusers", d.Id())
+	if err != nil {
+		return fmterr.Errorf("error getting cluster information: %w", err)
+	}
+
+	resp := make(map[string]internalUser)
+	if err := client.getJSON(ctx, path, &resp); err != nil {
+		return common.CheckDeletedDiag(d, err, "CSS user")
+	}
+	user, ok := resp[d.Id()]
+	if !ok {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "CSS user")
+	}
+	roles := user.Roles
+	if len(roles) == 0 {
+		roles = user.SecurityRoles
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", d.Id()),
+		d.Set("roles", roles),
+		d.Set("backend_roles", user.BackendRoles),
+		d.Set("attributes", user.Attributes),
+		d.Set("description", user.Description),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCssUserV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newEsClient(ctx, d, meta)
+	if err != nil {
+		return fmterr.Errorf(esClientError, err)
+	}
+	path, err := cssSecurityPath(ctx, client, "internalusers", d.Id())
+	if err != nil {
+		return fmterr.Errorf("error getting cluster information: %w", err)
+	}
+
+	if _, err := client.do(ctx, http.MethodDelete, path, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CSS user")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package dds
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// The DDS v3 audit log policy API is not covered by gophertelekomcloud yet.
+
+type SetAuditLogPolicyOpts struct {
+	// Number of days for storing audit logs. The value ranges from 7 to 732.
+	// The value 0 indicates that the audit log policy is disabled.
+	KeepDays *int `json:"keep_days" required:"true"`
+	// Whether the historical audit logs are retained when SQL audit is disabled: `true` or `false`.
+	// This parameter is valid only when SQL audit is disabled.
+	ReserveAuditLogs string `json:"reserve_auditlogs,omitempty"`
+	// Audit scope. `all` or a comma-separated list of databases and collections.
+	AuditScope string `json:"audit_scope,omitempty"`
+	// Audit types, e.g. `auth`, `insert`, `delete`, `update`, `query`, `command`.
+	AuditTypes []string `json:"audit_types,omitempty"`
+}
+
+func SetAuditLogPolicy(client *golangsdk.ServiceClient, instanceId string, opts SetAuditLogPolicyOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/auditlog-policy
+	_, err = client.Post(client.ServiceURL("instances", instanceId, "auditlog-policy"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+type AuditLogPolicy struct {
+	KeepDays   int      `json:"keep_days"`
+	AuditScope string   `json:"audit_scope"`
+	AuditTypes []string `json:"audit_types"`
+}
+
+func GetAuditLogPolicy(client *golangsdk.ServiceClient, instanceId string) (*AuditLogPolicy, error) {
+	// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/auditlog-policy
+	var res AuditLogPolicy
+	_, err := client.Get(client.ServiceURL("instances", instanceId, "auditlog-policy"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
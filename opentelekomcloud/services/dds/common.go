@@ -3,6 +3,7 @@ package dds
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 
 	"github.com/jmespath/go-jmespath"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
@@ -14,6 +15,8 @@ const (
 )
 
 var (
+	databaseObjectNameRegex = regexp.MustCompile(`^[\w.-]+$`)
+
 	retryErrCodes = map[string]struct{}{
 		"DBS.200019":   {}, // An operation that conflicts with the current operation is in progress.
 		"DBS.201014":   {},
@@ -69,3 +72,23 @@ func handleMultiOperationsError(err error) (bool, error) {
 	// Operation execution failed due to some resource or server issues, no need to try again.
 	return false, err
 }
+
+// parseDdsInstanceNotFoundError converts the error returned for a deleted instance into golangsdk.ErrDefault404.
+func parseDdsInstanceNotFoundError(err error) error {
+	if errCode, ok := err.(golangsdk.ErrDefault400); ok {
+		var apiError interface{}
+		if jsonErr := json.Unmarshal(errCode.Body, &apiError); jsonErr != nil {
+			return err
+		}
+
+		errorCode, errorCodeErr := jmespath.Search("error_code", apiError)
+		if errorCodeErr != nil {
+			return err
+		}
+
+		if errorCode == "DBS.200823" || errorCode == "DBS.201502" {
+			return golangsdk.ErrDefault404(errCode)
+		}
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+package dds
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type CreateDatabaseRoleOpts struct {
+	// Name of the role. The value contains 1 to 64 characters.
+	Name string `json:"role_name" required:"true"`
+	// Name of the database where the role is created. Defaults to `admin`.
+	DbName string `json:"db_name,omitempty"`
+	// Roles inherited by the new role.
+	Roles []DatabaseRoleRef `json:"roles,omitempty"`
+}
+
+func CreateDatabaseRole(client *golangsdk.ServiceClient, instanceId string, opts CreateDatabaseRoleOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db-role
+	_, err = client.Post(client.ServiceURL("instances", instanceId, "db-role"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+type ListDatabaseRolesOpts struct {
+	Name   string `q:"role_name"`
+	DbName string `q:"db_name"`
+	Offset int    `q:"offset"`
+	Limit  int    `q:"limit"`
+}
+
+type DatabaseRole struct {
+	Name                string              `json:"role_name"`
+	DbName              string              `json:"db_name"`
+	Roles               []DatabaseRoleRef   `json:"roles"`
+	Privileges          []DatabasePrivilege `json:"privileges"`
+	InheritedPrivileges []DatabasePrivilege `json:"inherited_privileges"`
+}
+
+type listDatabaseRolesResponse struct {
+	Roles      []DatabaseRole `json:"roles"`
+	TotalCount int            `json:"total_count"`
+}
+
+func ListDatabaseRoles(client *golangsdk.ServiceClient, instanceId string, opts ListDatabaseRolesOpts) ([]DatabaseRole, error) {
+	var roles []DatabaseRole
+	if opts.Limit == 0 {
+		opts.Limit = 100
+	}
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("instances", instanceId, "db-roles").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db-roles
+		var res listDatabaseRolesResponse
+		_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, res.Roles...)
+		if len(res.Roles) == 0 || len(roles) >= res.TotalCount {
+			return roles, nil
+		}
+		opts.Offset += len(res.Roles)
+	}
+}
+
+type DeleteDatabaseRoleOpts struct {
+	Name   string `json:"role_name" required:"true"`
+	DbName string `json:"db_name" required:"true"`
+}
+
+func DeleteDatabaseRole(client *golangsdk.ServiceClient, instanceId string, opts DeleteDatabaseRoleOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// DELETE https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db-role
+	_, err = client.DeleteWithBody(client.ServiceURL("instances", instanceId, "db-role"), b, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
@@ -0,0 +1,134 @@
+package dds
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// The DDS v3 database user and role APIs are not covered by gophertelekomcloud yet,
+// so the requests are built here in the same way as the SDK does it.
+
+type DatabaseRoleRef struct {
+	// Name of the role.
+	Name string `json:"role_name"`
+	// Name of the database the role belongs to.
+	DbName string `json:"role_db_name"`
+}
+
+type DatabasePrivilege struct {
+	Resource DatabasePrivilegeResource `json:"resource"`
+	// Allowed actions, e.g. `find`, `insert`.
+	Actions []string `json:"actions"`
+}
+
+type DatabasePrivilegeResource struct {
+	Collection string `json:"collection"`
+	DbName     string `json:"db_name"`
+}
+
+type CreateDatabaseUserOpts struct {
+	// Name of the user. The value contains 1 to 64 characters.
+	Name string `json:"user_name" required:"true"`
+	// Password of the user.
+	Password string `json:"user_pwd" required:"true"`
+	// Name of the database where the user is created. Defaults to `admin`.
+	DbName string `json:"db_name,omitempty"`
+	// Roles inherited by the user.
+	Roles []DatabaseRoleRef `json:"roles" required:"true"`
+}
+
+func CreateDatabaseUser(client *golangsdk.ServiceClient, instanceId string, opts CreateDatabaseUserOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db-user
+	_, err = client.Post(client.ServiceURL("instances", instanceId, "db-user"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+type ListDatabaseUsersOpts struct {
+	Name   string `q:"user_name"`
+	DbName string `q:"db_name"`
+	Offset int    `q:"offset"`
+	Limit  int    `q:"limit"`
+}
+
+type DatabaseUser struct {
+	Name                string              `json:"user_name"`
+	DbName              string              `json:"db_name"`
+	Roles               []DatabaseRoleRef   `json:"roles"`
+	Privileges          []DatabasePrivilege `json:"privileges"`
+	InheritedPrivileges []DatabasePrivilege `json:"inherited_privileges"`
+}
+
+type listDatabaseUsersResponse struct {
+	Users      []DatabaseUser `json:"users"`
+	TotalCount int            `json:"total_count"`
+}
+
+func ListDatabaseUsers(client *golangsdk.ServiceClient, instanceId string, opts ListDatabaseUsersOpts) ([]DatabaseUser, error) {
+	var users []DatabaseUser
+	if opts.Limit == 0 {
+		opts.Limit = 100
+	}
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("instances", instanceId, "db-user", "detail").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db-user/detail
+		var res listDatabaseUsersResponse
+		_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, res.Users...)
+		if len(res.Users) == 0 || len(users) >= res.TotalCount {
+			return users, nil
+		}
+		opts.Offset += len(res.Users)
+	}
+}
+
+type ResetDatabaseUserPasswordOpts struct {
+	Name     string `json:"user_name" required:"true"`
+	Password string `json:"user_pwd" required:"true"`
+	DbName   string `json:"db_name,omitempty"`
+}
+
+func ResetDatabaseUserPassword(client *golangsdk.ServiceClient, instanceId string, opts ResetDatabaseUserPasswordOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT https://{Endpoint}/v3/{project_id}/instances/{instance_id}/reset-password
+	_, err = client.Put(client.ServiceURL("instances", instanceId, "reset-password"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+type DeleteDatabaseUserOpts struct {
+	Name   string `json:"user_name" required:"true"`
+	DbName string `json:"db_name" required:"true"`
+}
+
+func DeleteDatabaseUser(client *golangsdk.ServiceClient, instanceId string, opts DeleteDatabaseUserOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// DELETE https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db-user
+	_, err = client.DeleteWithBody(client.ServiceURL("instances", instanceId, "db-user"), b, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
@@ -0,0 +1,79 @@
+package dds
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Creation, update and deletion of DDS v3 parameter templates are not covered by gophertelekomcloud yet.
+// Reading and applying templates is done with the `configurations` package of the SDK.
+
+type CreateParameterTemplateOpts struct {
+	// Parameter template name. The value contains 1 to 64 characters.
+	Name string `json:"name" required:"true"`
+	// Parameter template description.
+	Description string `json:"description,omitempty"`
+	// Database information.
+	Datastore ParameterTemplateDatastore `json:"datastore" required:"true"`
+	// Parameter values defined by users based on the default parameter template.
+	ParameterValues map[string]string `json:"parameter_values,omitempty"`
+}
+
+type ParameterTemplateDatastore struct {
+	// Database type. The value is `DDS-Community`.
+	Type string `json:"type" required:"true"`
+	// Database version, e.g. `3.4`, `4.0`, `4.2`.
+	Version string `json:"version" required:"true"`
+	// Node type of the template: `mongos`, `shard`, `config`, `replica` or `single`.
+	NodeType string `json:"node_type" required:"true"`
+}
+
+type createParameterTemplateResponse struct {
+	Configuration struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"configuration"`
+}
+
+func CreateParameterTemplate(client *golangsdk.ServiceClient, opts CreateParameterTemplateOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/configurations
+	var res createParameterTemplateResponse
+	_, err = client.Post(client.ServiceURL("configurations"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.Configuration.ID, nil
+}
+
+type UpdateParameterTemplateOpts struct {
+	Name            string            `json:"name,omitempty"`
+	Description     *string           `json:"description,omitempty"`
+	ParameterValues map[string]string `json:"parameter_values,omitempty"`
+}
+
+func UpdateParameterTemplate(client *golangsdk.ServiceClient, configId string, opts UpdateParameterTemplateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT https://{Endpoint}/v3/{project_id}/configurations/{config_id}
+	_, err = client.Put(client.ServiceURL("configurations", configId), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeleteParameterTemplate(client *golangsdk.ServiceClient, configId string) error {
+	// DELETE https://{Endpoint}/v3/{project_id}/configurations/{config_id}
+	_, err := client.Delete(client.ServiceURL("configurations", configId), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
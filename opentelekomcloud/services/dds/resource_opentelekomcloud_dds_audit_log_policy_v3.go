@@ -0,0 +1,204 @@
+package dds
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDdsAuditLogPolicyV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdsAuditLogPolicyV3Create,
+		ReadContext:   resourceDdsAuditLogPolicyV3Read,
+		UpdateContext: resourceDdsAuditLogPolicyV3Update,
+		DeleteContext: resourceDdsAuditLogPolicyV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ddsAuditLogPolicyV3ImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"keep_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(7, 732),
+			},
+			"audit_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"audit_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"auth", "insert", "delete", "update", "query", "command",
+					}, false),
+				},
+			},
+			"reserve_auditlogs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDdsAuditLogPolicyV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	if err := setDdsAuditLogPolicy(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error setting DDSv3 audit log policy: %w", err)
+	}
+	d.SetId(instanceId)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceDdsAuditLogPolicyV3Read(clientCtx, d, meta)
+}
+
+func resourceDdsAuditLogPolicyV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	policy, err := GetAuditLogPolicy(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, parseDdsInstanceNotFoundError(err), "error retrieving DDSv3 audit log policy")
+	}
+	if policy.KeepDays == 0 {
+		// audit log is disabled, so the policy is gone
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", d.Id()),
+		d.Set("keep_days", policy.KeepDays),
+		d.Set("audit_scope", policy.AuditScope),
+		d.Set("audit_types", policy.AuditTypes),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DDSv3 audit log policy fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDdsAuditLogPolicyV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	if d.HasChanges("keep_days", "audit_scope", "audit_types") {
+		if err := setDdsAuditLogPolicy(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error updating DDSv3 audit log policy: %w", err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceDdsAuditLogPolicyV3Read(clientCtx, d, meta)
+}
+
+func resourceDdsAuditLogPolicyV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	keepDays := 0
+	opts := SetAuditLogPolicyOpts{
+		KeepDays:         &keepDays,
+		ReserveAuditLogs: strconv.FormatBool(d.Get("reserve_auditlogs").(bool)),
+	}
+	if err := retrySetDdsAuditLogPolicy(ctx, client, d.Id(), opts, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return common.CheckDeletedDiag(d, parseDdsInstanceNotFoundError(err), "error disabling DDSv3 audit log policy")
+	}
+
+	return nil
+}
+
+func setDdsAuditLogPolicy(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, timeout time.Duration) error {
+	keepDays := d.Get("keep_days").(int)
+	opts := SetAuditLogPolicyOpts{
+		KeepDays:   &keepDays,
+		AuditScope: d.Get("audit_scope").(string),
+		AuditTypes: common.ExpandToStringListBySet(d.Get("audit_types").(*schema.Set)),
+	}
+	return retrySetDdsAuditLogPolicy(ctx, client, d.Get("instance_id").(string), opts, timeout)
+}
+
+func retrySetDdsAuditLogPolicy(ctx context.Context, client *golangsdk.ServiceClient, instanceId string, opts SetAuditLogPolicyOpts, timeout time.Duration) error {
+	retryFunc := func() (interface{}, bool, error) {
+		err := SetAuditLogPolicy(client, instanceId, opts)
+		retry, err := handleMultiOperationsError(err)
+		return nil, retry, err
+	}
+	_, err := common.RetryContextWithWaitForState(&common.RetryContextWithWaitForStateParam{
+		Ctx:          ctx,
+		RetryFunc:    retryFunc,
+		WaitFunc:     instanceStateRefreshFunc(client, instanceId),
+		WaitTarget:   []string{"normal"},
+		Timeout:      timeout,
+		DelayTimeout: 1 * time.Second,
+		PollInterval: 10 * time.Second,
+	})
+	return err
+}
+
+func ddsAuditLogPolicyV3ImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", d.Id()),
+		d.Set("reserve_auditlogs", true),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,178 @@
+package dds
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDdsDatabaseRoleV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdsDatabaseRoleV3Create,
+		ReadContext:   resourceDdsDatabaseRoleV3Read,
+		DeleteContext: resourceDdsDatabaseRoleV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("instance_id", "db_name", "name"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(databaseObjectNameRegex, "only letters, digits, hyphens (-), underscores (_) and dots (.) are allowed"),
+				),
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "admin",
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     databaseRoleRefSchema(),
+			},
+			"privileges":           databasePrivilegesSchema(),
+			"inherited_privileges": databasePrivilegesSchema(),
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDdsDatabaseRoleV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	opts := CreateDatabaseRoleOpts{
+		Name:   d.Get("name").(string),
+		DbName: d.Get("db_name").(string),
+		Roles:  expandDatabaseRoleRefs(d.Get("roles").([]interface{})),
+	}
+	retryFunc := func() (interface{}, bool, error) {
+		err := CreateDatabaseRole(client, instanceId, opts)
+		retry, err := handleMultiOperationsError(err)
+		return nil, retry, err
+	}
+	_, err = common.RetryContextWithWaitForState(&common.RetryContextWithWaitForStateParam{
+		Ctx:          ctx,
+		RetryFunc:    retryFunc,
+		WaitFunc:     instanceStateRefreshFunc(client, instanceId),
+		WaitTarget:   []string{"normal"},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		DelayTimeout: 1 * time.Second,
+		PollInterval: 10 * time.Second,
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating DDS database role: %w", err)
+	}
+
+	if err := common.SetComplexID(d, "instance_id", "db_name", "name"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceDdsDatabaseRoleV3Read(clientCtx, d, meta)
+}
+
+func resourceDdsDatabaseRoleV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	roles, err := ListDatabaseRoles(client, d.Get("instance_id").(string), ListDatabaseRolesOpts{
+		Name:   d.Get("name").(string),
+		DbName: d.Get("db_name").(string),
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, parseDdsInstanceNotFoundError(err), "error fetching DDS database role")
+	}
+	if len(roles) == 0 {
+		d.SetId("")
+		return nil
+	}
+	role := roles[0]
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", role.Name),
+		d.Set("db_name", role.DbName),
+		d.Set("roles", flattenDatabaseRoleRefs(role.Roles)),
+		d.Set("privileges", flattenDatabasePrivileges(role.Privileges)),
+		d.Set("inherited_privileges", flattenDatabasePrivileges(role.InheritedPrivileges)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DDS database role fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDdsDatabaseRoleV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	opts := DeleteDatabaseRoleOpts{
+		Name:   d.Get("name").(string),
+		DbName: d.Get("db_name").(string),
+	}
+	retryFunc := func() (interface{}, bool, error) {
+		err := DeleteDatabaseRole(client, instanceId, opts)
+		retry, err := handleMultiOperationsError(err)
+		return nil, retry, err
+	}
+	_, err = common.RetryContextWithWaitForState(&common.RetryContextWithWaitForStateParam{
+		Ctx:          ctx,
+		RetryFunc:    retryFunc,
+		WaitFunc:     instanceStateRefreshFunc(client, instanceId),
+		WaitTarget:   []string{"normal"},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		DelayTimeout: 1 * time.Second,
+		PollInterval: 10 * time.Second,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, parseDdsInstanceNotFoundError(err), "error deleting DDS database role")
+	}
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package dds
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDdsDatabaseUserV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdsDatabaseUserV3Create,
+		ReadContext:   resourceDdsDatabaseUserV3Read,
+		UpdateContext: resourceDdsDatabaseUserV3Update,
+		DeleteContext: resourceDdsDatabaseUserV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("instance_id", "db_name", "name"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(databaseObjectNameRegex, "only letters, digits, hyphens (-), underscores (_) and dots (.) are allowed"),
+				),
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "admin",
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     databaseRoleRefSchema(),
+			},
+			"privileges":           databasePrivilegesSchema(),
+			"inherited_privileges": databasePrivilegesSchema(),
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDdsDatabaseUserV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	opts := CreateDatabaseUserOpts{
+		Name:     d.Get("name").(string),
+		Password: d.Get("password").(string),
+		DbName:   d.Get("db_name").(string),
+		Roles:    expandDatabaseRoleRefs(d.Get("roles").([]interface{})),
+	}
+	retryFunc := func() (interface{}, bool, error) {
+		err := CreateDatabaseUser(client, instanceId, opts)
+		retry, err := handleMultiOperationsError(err)
+		return nil, retry, err
+	}
+	_, err = common.RetryContextWithWaitForState(&common.RetryContextWithWaitForStateParam{
+		Ctx:          ctx,
+		RetryFunc:    retryFunc,
+		WaitFunc:     instanceStateRefreshFunc(client, instanceId),
+		WaitTarget:   []string{"normal"},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		DelayTimeout: 1 * time.Second,
+		PollInterval: 10 * time.Second,
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating DDS database user: %w", err)
+	}
+
+	if err := common.SetComplexID(d, "instance_id", "db_name", "name"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceDdsDatabaseUserV3Read(clientCtx, d, meta)
+}
+
+func resourceDdsDatabaseUserV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	users, err := ListDatabaseUsers(client, instanceId, ListDatabaseUsersOpts{
+		Name:   d.Get("name").(string),
+		DbName: d.Get("db_name").(string),
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, parseDdsInstanceNotFoundError(err), "error fetching DDS database user")
+	}
+	if len(users) == 0 {
+		d.SetId("")
+		return nil
+	}
+	user := users[0]
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", user.Name),
+		d.Set("db_name", user.DbName),
+		d.Set("roles", flattenDatabaseRoleRefs(user.Roles)),
+		d.Set("privileges", flattenDatabasePrivileges(user.Privileges)),
+		d.Set("inherited_privileges", flattenDatabasePrivileges(user.InheritedPrivileges)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DDS database user fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDdsDatabaseUserV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	if d.HasChange("password") {
+		instanceId := d.Get("instance_id").(string)
+		opts := ResetDatabaseUserPasswordOpts{
+			Name:     d.Get("name").(string),
+			Password: d.Get("password").(string),
+			DbName:   d.Get("db_name").(string),
+		}
+		retryFunc := func() (interface{}, bool, error) {
+			err := ResetDatabaseUserPassword(client, instanceId, opts)
+			retry, err := handleMultiOperationsError(err)
+			return nil, retry, err
+		}
+		_, err = common.RetryContextWithWaitForState(&common.RetryContextWithWaitForStateParam{
+			Ctx:          ctx,
+			RetryFunc:    retryFunc,
+			WaitFunc:     instanceStateRefreshFunc(client, instanceId),
+			WaitTarget:   []string{"normal"},
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			DelayTimeout: 1 * time.Second,
+			PollInterval: 10 * time.Second,
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating DDS database user password: %w", err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceDdsDatabaseUserV3Read(clientCtx, d, meta)
+}
+
+func resourceDdsDatabaseUserV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	opts := DeleteDatabaseUserOpts{
+		Name:   d.Get("name").(string),
+		DbName: d.Get("db_name").(string),
+	}
+	retryFunc := func() (interface{}, bool, error) {
+		err := DeleteDatabaseUser(client, instanceId, opts)
+		retry, err := handleMultiOperationsError(err)
+		return nil, retry, err
+	}
+	_, err = common.RetryContextWithWaitForState(&common.RetryContextWithWaitForStateParam{
+		Ctx:          ctx,
+		RetryFunc:    retryFunc,
+		WaitFunc:     instanceStateRefreshFunc(client, instanceId),
+		WaitTarget:   []string{"normal"},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		DelayTimeout: 1 * time.Second,
+		PollInterval: 10 * time.Second,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, parseDdsInstanceNotFoundError(err), "error deleting DDS database user")
+	}
+
+	return nil
+}
+
+func databaseRoleRefSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func databasePrivilegesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resources": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"collection": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"db_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"actions": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandDatabaseRoleRefs(raw []interface{}) []DatabaseRoleRef {
+	roles := make([]DatabaseRoleRef, 0, len(raw))
+	for _, v := range raw {
+		role := v.(map[string]interface{})
+		roles = append(roles, DatabaseRoleRef{
+			Name:   role["name"].(string),
+			DbName: role["db_name"].(string),
+		})
+	}
+	return roles
+}
+
+func flattenDatabaseRoleRefs(roles []DatabaseRoleRef) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, map[string]interface{}{
+			"name":    role.Name,
+			"db_name": role.DbName,
+		})
+	}
+	return result
+}
+
+func flattenDatabasePrivileges(privileges []DatabasePrivilege) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(privileges))
+	for _, privilege := range privileges {
+		result = append(result, map[string]interface{}{
+			"resources": []map[string]interface{}{
+				{
+					"collection": privilege.Resource.Collection,
+					"db_name":    privilege.Resource.DbName,
+				},
+			},
+			"actions": privilege.Actions,
+		})
+	}
+	return result
+}
@@ -0,0 +1,234 @@
+package dds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/configurations"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDdsParameterTemplateAssociateV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdsParameterTemplateAssociateV3Create,
+		ReadContext:   resourceDdsParameterTemplateAssociateV3Read,
+		UpdateContext: resourceDdsParameterTemplateAssociateV3Update,
+		DeleteContext: resourceDdsParameterTemplateAssociateV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("instance_id", "entity_id"),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"entity_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"template_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"restart_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"pending_restart_parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDdsParameterTemplateAssociateV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	entityId := d.Get("entity_id").(string)
+	if entityId == "" {
+		// replica set and single node instances are addressed by the instance ID
+		entityId = instanceId
+		if err := d.Set("entity_id", entityId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := applyDdsParameterTemplate(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := common.SetComplexID(d, "instance_id", "entity_id"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceDdsParameterTemplateAssociateV3Read(clientCtx, d, meta)
+}
+
+func resourceDdsParameterTemplateAssociateV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	template, err := configurations.GetInstanceConfig(client, d.Get("instance_id").(string), configurations.ConfigOpts{
+		EntityId: d.Get("entity_id").(string),
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, parseDdsInstanceNotFoundError(err), "error retrieving DDSv3 instance parameter template")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("template_id", template.ID),
+		d.Set("template_name", template.Name),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DDSv3 parameter template association fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDdsParameterTemplateAssociateV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	if d.HasChange("template_id") {
+		if err := applyDdsParameterTemplate(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceDdsParameterTemplateAssociateV3Read(clientCtx, d, meta)
+}
+
+func resourceDdsParameterTemplateAssociateV3Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// A DDS instance always has a parameter template applied, so there is nothing to detach.
+	log.Printf("[DEBUG] Parameter template association %s is removed from the state only", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// applyDdsParameterTemplate applies the template to the entity and stores which changed parameters
+// take effect only after the restart of the entity.
+func applyDdsParameterTemplate(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, timeout time.Duration) error {
+	instanceId := d.Get("instance_id").(string)
+	entityId := d.Get("entity_id").(string)
+	templateId := d.Get("template_id").(string)
+
+	current, err := configurations.GetInstanceConfig(client, instanceId, configurations.ConfigOpts{
+		EntityId: entityId,
+	})
+	if err != nil {
+		return fmt.Errorf("error retrieving current DDSv3 parameter template of %s: %w", entityId, err)
+	}
+	target, err := configurations.Get(client, templateId)
+	if err != nil {
+		return fmt.Errorf("error retrieving DDSv3 parameter template %s: %w", templateId, err)
+	}
+	pending := restartRequiredParameters(current.Parameters, target.Parameters)
+
+	retryFunc := func() (interface{}, bool, error) {
+		resp, err := configurations.Apply(client, templateId, configurations.ApplyOpts{
+			EntityIDs: []string{entityId},
+		})
+		retry, err := handleMultiOperationsError(err)
+		return resp, retry, err
+	}
+	r, err := common.RetryContextWithWaitForState(&common.RetryContextWithWaitForStateParam{
+		Ctx:          ctx,
+		RetryFunc:    retryFunc,
+		WaitFunc:     instanceStateRefreshFunc(client, instanceId),
+		WaitTarget:   []string{"normal"},
+		Timeout:      timeout,
+		DelayTimeout: 1 * time.Second,
+		PollInterval: 10 * time.Second,
+	})
+	if err != nil {
+		return fmt.Errorf("error applying DDSv3 parameter template %s to %s: %w", templateId, entityId, err)
+	}
+
+	if jobId, ok := r.(*string); ok && jobId != nil && *jobId != "" {
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"Running"},
+			Target:       []string{"Completed"},
+			Refresh:      JobStateRefreshFunc(client, *jobId),
+			Timeout:      timeout,
+			Delay:        10 * time.Second,
+			PollInterval: 10 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for job (%s) to complete: %w", *jobId, err)
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("restart_required", len(pending) > 0),
+		d.Set("pending_restart_parameters", pending),
+	)
+	return mErr.ErrorOrNil()
+}
+
+// restartRequiredParameters returns names of the parameters changed between templates which require a restart.
+func restartRequiredParameters(current, target []configurations.Parameters) []string {
+	currentValues := make(map[string]string, len(current))
+	for _, p := range current {
+		currentValues[p.Name] = p.Value
+	}
+	pending := make([]string, 0)
+	for _, p := range target {
+		if !p.RestartRequired {
+			continue
+		}
+		if value, ok := currentValues[p.Name]; !ok || value != p.Value {
+			pending = append(pending, p.Name)
+		}
+	}
+	return pending
+}
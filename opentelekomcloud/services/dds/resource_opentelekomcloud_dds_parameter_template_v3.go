@@ -0,0 +1,268 @@
+package dds
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/configurations"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDdsParameterTemplateV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdsParameterTemplateV3Create,
+		ReadContext:   resourceDdsParameterTemplateV3Read,
+		UpdateContext: resourceDdsParameterTemplateV3Update,
+		DeleteContext: resourceDdsParameterTemplateV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"mongos", "shard", "config", "replica", "single",
+				}, false),
+			},
+			"datastore": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "DDS-Community",
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"parameter_values": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"restart_required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"value_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getParameterValues(d *schema.ResourceData) map[string]string {
+	m := make(map[string]string)
+	for key, val := range d.Get("parameter_values").(map[string]interface{}) {
+		m[key] = val.(string)
+	}
+	return m
+}
+
+func resourceDdsParameterTemplateV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	datastore := d.Get("datastore").([]interface{})[0].(map[string]interface{})
+	createOpts := CreateParameterTemplateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Datastore: ParameterTemplateDatastore{
+			Type:     datastore["type"].(string),
+			Version:  datastore["version"].(string),
+			NodeType: d.Get("node_type").(string),
+		},
+		ParameterValues: getParameterValues(d),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	id, err := CreateParameterTemplate(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating DDSv3 parameter template: %w", err)
+	}
+	d.SetId(id)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceDdsParameterTemplateV3Read(clientCtx, d, meta)
+}
+
+func resourceDdsParameterTemplateV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	template, err := configurations.Get(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DDSv3 parameter template")
+	}
+
+	// only parameters managed by the configuration are tracked, so the drift of them becomes visible
+	configured := d.Get("parameter_values").(map[string]interface{})
+	values := make(map[string]string)
+	parameters := make([]map[string]interface{}, len(template.Parameters))
+	for i, parameter := range template.Parameters {
+		parameters[i] = map[string]interface{}{
+			"name":             parameter.Name,
+			"value":            parameter.Value,
+			"restart_required": parameter.RestartRequired,
+			"readonly":         parameter.ReadOnly,
+			"value_range":      parameter.ValueRange,
+			"type":             parameter.Type,
+			"description":      parameter.Description,
+		}
+		if _, ok := configured[parameter.Name]; ok {
+			values[parameter.Name] = parameter.Value
+		}
+	}
+
+	datastore := []map[string]interface{}{
+		{
+			"type":    template.DatastoreName,
+			"version": template.DatastoreVersion,
+		},
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", template.Name),
+		d.Set("description", template.Description),
+		d.Set("node_type", template.NodeType),
+		d.Set("datastore", datastore),
+		d.Set("parameter_values", values),
+		d.Set("parameters", parameters),
+		d.Set("created", template.CreatedAt),
+		d.Set("updated", template.UpdatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DDSv3 parameter template fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDdsParameterTemplateV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	var updateOpts UpdateParameterTemplateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("parameter_values") {
+		updateOpts.ParameterValues = getParameterValues(d)
+	}
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+	if err := UpdateParameterTemplate(client, d.Id(), updateOpts); err != nil {
+		return fmterr.Errorf("error updating DDSv3 parameter template: %w", err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceDdsParameterTemplateV3Read(clientCtx, d, meta)
+}
+
+func resourceDdsParameterTemplateV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DdsV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	if err := DeleteParameterTemplate(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DDSv3 parameter template")
+	}
+
+	return nil
+}
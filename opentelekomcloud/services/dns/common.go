@@ -1,6 +1,13 @@
 package dns
 
+import "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/mutexkv"
+
 const (
-	errCreationClient = "error creating OpenTelekomCloud DNSv2 client: %w"
-	keyClientV2       = "dns-v2-client"
+	errCreationClient    = "error creating OpenTelekomCloud DNSv2 client: %w"
+	errCreationV21Client = "error creating OpenTelekomCloud DNSv2.1 client: %w"
+	keyClientV2          = "dns-v2-client"
+	keyClientV21         = "dns-v2.1-client"
 )
+
+// zoneMutexKV serializes router (dis)association of the same zone
+var zoneMutexKV = mutexkv.NewMutexKV()
@@ -0,0 +1,235 @@
+package dns
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Endpoints and resolver rules are not covered by gophertelekomcloud yet.
+
+type EndpointIpAddressOpts struct {
+	SubnetID string `json:"subnet_id"`
+	IP       string `json:"ip,omitempty"`
+}
+
+type CreateEndpointOpts struct {
+	Name        string                  `json:"name"`
+	Direction   string                  `json:"direction"`
+	Region      string                  `json:"region"`
+	IpAddresses []EndpointIpAddressOpts `json:"ipaddresses"`
+}
+
+type Endpoint struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Direction         string `json:"direction"`
+	Status            string `json:"status"`
+	VpcID             string `json:"vpc_id"`
+	IpAddressCount    int    `json:"ipaddress_count"`
+	ResolverRuleCount int    `json:"resolver_rule_count"`
+	CreatedAt         string `json:"create_time"`
+	UpdatedAt         string `json:"update_time"`
+}
+
+type EndpointIpAddress struct {
+	ID       string `json:"id"`
+	IP       string `json:"ip"`
+	SubnetID string `json:"subnet_id"`
+	Status   string `json:"status"`
+}
+
+type RuleIpAddress struct {
+	IP string `json:"ip"`
+}
+
+type CreateResolverRuleOpts struct {
+	Name        string          `json:"name"`
+	DomainName  string          `json:"domain_name"`
+	EndpointID  string          `json:"endpoint_id"`
+	IpAddresses []RuleIpAddress `json:"ipaddresses"`
+}
+
+type UpdateResolverRuleOpts struct {
+	Name        string          `json:"name,omitempty"`
+	IpAddresses []RuleIpAddress `json:"ipaddresses,omitempty"`
+}
+
+type ResolverRuleRouter struct {
+	RouterID     string `json:"router_id"`
+	RouterRegion string `json:"router_region"`
+	Status       string `json:"status,omitempty"`
+}
+
+type ResolverRule struct {
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	DomainName  string               `json:"domain_name"`
+	EndpointID  string               `json:"endpoint_id"`
+	Status      string               `json:"status"`
+	RuleType    string               `json:"rule_type"`
+	IpAddresses []RuleIpAddress      `json:"ipaddresses"`
+	Routers     []ResolverRuleRouter `json:"routers"`
+	CreatedAt   string               `json:"create_time"`
+	UpdatedAt   string               `json:"update_time"`
+}
+
+func CreateEndpoint(client *golangsdk.ServiceClient, opts CreateEndpointOpts) (*Endpoint, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v2.1/endpoints
+	var res struct {
+		Endpoint Endpoint `json:"endpoint"`
+	}
+	_, err = client.Post(client.ServiceURL("endpoints"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Endpoint, nil
+}
+
+func GetEndpoint(client *golangsdk.ServiceClient, id string) (*Endpoint, error) {
+	// GET /v2.1/endpoints/{endpoint_id}
+	var res struct {
+		Endpoint Endpoint `json:"endpoint"`
+	}
+	_, err := client.Get(client.ServiceURL("endpoints", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Endpoint, nil
+}
+
+func UpdateEndpoint(client *golangsdk.ServiceClient, id, name string) error {
+	b := map[string]interface{}{
+		"name": name,
+	}
+
+	// PUT /v2.1/endpoints/{endpoint_id}
+	_, err := client.Put(client.ServiceURL("endpoints", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func DeleteEndpoint(client *golangsdk.ServiceClient, id string) error {
+	// DELETE /v2.1/endpoints/{endpoint_id}
+	_, err := client.Delete(client.ServiceURL("endpoints", id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	return err
+}
+
+func ListEndpointIpAddresses(client *golangsdk.ServiceClient, id string) ([]EndpointIpAddress, error) {
+	// GET /v2.1/endpoints/{endpoint_id}/ipaddresses
+	var res struct {
+		IpAddresses []EndpointIpAddress `json:"ipaddresses"`
+	}
+	_, err := client.Get(client.ServiceURL("endpoints", id, "ipaddresses"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.IpAddresses, nil
+}
+
+func AddEndpointIpAddress(client *golangsdk.ServiceClient, id string, opts EndpointIpAddressOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "ipaddress")
+	if err != nil {
+		return err
+	}
+
+	// POST /v2.1/endpoints/{endpoint_id}/ipaddresses
+	_, err = client.Post(client.ServiceURL("endpoints", id, "ipaddresses"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func RemoveEndpointIpAddress(client *golangsdk.ServiceClient, id, ipAddressID string) error {
+	// DELETE /v2.1/endpoints/{endpoint_id}/ipaddresses/{ipaddress_id}
+	_, err := client.Delete(client.ServiceURL("endpoints", id, "ipaddresses", ipAddressID), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	return err
+}
+
+func CreateResolverRule(client *golangsdk.ServiceClient, opts CreateResolverRuleOpts) (*ResolverRule, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v2.1/resolverrules
+	var res struct {
+		ResolverRule ResolverRule `json:"resolver_rule"`
+	}
+	_, err = client.Post(client.ServiceURL("resolverrules"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.ResolverRule, nil
+}
+
+func GetResolverRule(client *golangsdk.ServiceClient, id string) (*ResolverRule, error) {
+	// GET /v2.1/resolverrules/{resolverrule_id}
+	var res struct {
+		ResolverRule ResolverRule `json:"resolver_rule"`
+	}
+	_, err := client.Get(client.ServiceURL("resolverrules", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.ResolverRule, nil
+}
+
+func UpdateResolverRule(client *golangsdk.ServiceClient, id string, opts UpdateResolverRuleOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v2.1/resolverrules/{resolverrule_id}
+	_, err = client.Put(client.ServiceURL("resolverrules", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func DeleteResolverRule(client *golangsdk.ServiceClient, id string) error {
+	// DELETE /v2.1/resolverrules/{resolverrule_id}
+	_, err := client.Delete(client.ServiceURL("resolverrules", id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	return err
+}
+
+func AssociateResolverRuleRouter(client *golangsdk.ServiceClient, id string, router ResolverRuleRouter) error {
+	b, err := golangsdk.BuildRequestBody(router, "router")
+	if err != nil {
+		return err
+	}
+
+	// POST /v2.1/resolverrules/{resolverrule_id}/associaterouter
+	_, err = client.Post(client.ServiceURL("resolverrules", id, "associaterouter"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func DisassociateResolverRuleRouter(client *golangsdk.ServiceClient, id string, router ResolverRuleRouter) error {
+	b, err := golangsdk.BuildRequestBody(router, "router")
+	if err != nil {
+		return err
+	}
+
+	// POST /v2.1/resolverrules/{resolverrule_id}/disassociaterouter
+	_, err = client.Post(client.ServiceURL("resolverrules", id, "disassociaterouter"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
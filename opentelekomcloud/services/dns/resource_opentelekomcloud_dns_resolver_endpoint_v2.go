@@ -0,0 +1,329 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDNSResolverEndpointV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSResolverEndpointV2Create,
+		ReadContext:   resourceDNSResolverEndpointV2Read,
+		UpdateContext: resourceDNSResolverEndpointV2Update,
+		DeleteContext: resourceDNSResolverEndpointV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"inbound", "outbound",
+				}, false),
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 6,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ip": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"ip_address_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resolver_rule_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandEndpointIpAddresses(raw []interface{}) []EndpointIpAddressOpts {
+	res := make([]EndpointIpAddressOpts, len(raw))
+	for i, v := range raw {
+		address := v.(map[string]interface{})
+		res[i] = EndpointIpAddressOpts{
+			SubnetID: address["subnet_id"].(string),
+			IP:       address["ip"].(string),
+		}
+	}
+	return res
+}
+
+func resourceDNSResolverEndpointV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	client, err := common.ClientFromCtx(ctx, keyClientV21, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV21Client(region)
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV21Client, err)
+	}
+
+	opts := CreateEndpointOpts{
+		Name:        d.Get("name").(string),
+		Direction:   d.Get("direction").(string),
+		Region:      region,
+		IpAddresses: expandEndpointIpAddresses(d.Get("ip_addresses").([]interface{})),
+	}
+	log.Printf("[DEBUG] Create DNS endpoint options: %#v", opts)
+	endpoint, err := CreateEndpoint(client, opts)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS endpoint: %s", logHttpError(err))
+	}
+	d.SetId(endpoint.ID)
+
+	if err := waitForDNSEndpointActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV21)
+	return resourceDNSResolverEndpointV2Read(clientCtx, d, meta)
+}
+
+func resourceDNSResolverEndpointV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV21, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV21Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV21Client, err)
+	}
+
+	endpoint, err := GetEndpoint(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "DNS endpoint")
+	}
+
+	addresses, err := ListEndpointIpAddresses(client, d.Id())
+	if err != nil {
+		return fmterr.Errorf("error fetching OpenTelekomCloud DNS endpoint (%s) IP addresses: %s", d.Id(), err)
+	}
+	ipAddresses := make([]map[string]interface{}, len(addresses))
+	for i, address := range addresses {
+		ipAddresses[i] = map[string]interface{}{
+			"subnet_id":     address.SubnetID,
+			"ip":            address.IP,
+			"ip_address_id": address.ID,
+			"status":        address.Status,
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", endpoint.Name),
+		d.Set("direction", endpoint.Direction),
+		d.Set("vpc_id", endpoint.VpcID),
+		d.Set("status", endpoint.Status),
+		d.Set("resolver_rule_count", endpoint.ResolverRuleCount),
+		d.Set("created_at", endpoint.CreatedAt),
+		d.Set("updated_at", endpoint.UpdatedAt),
+		d.Set("ip_addresses", ipAddresses),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DNS endpoint fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDNSResolverEndpointV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV21, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV21Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV21Client, err)
+	}
+
+	if d.HasChange("name") {
+		if err := UpdateEndpoint(client, d.Id(), d.Get("name").(string)); err != nil {
+			return fmterr.Errorf("error updating OpenTelekomCloud DNS endpoint: %s", logHttpError(err))
+		}
+		if err := waitForDNSEndpointActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("ip_addresses") {
+		oldRaw, newRaw := d.GetChange("ip_addresses")
+		oldList := oldRaw.([]interface{})
+		newList := newRaw.([]interface{})
+
+		// new addresses are added first, so the endpoint never has less than two of them
+		for _, n := range newList {
+			address := n.(map[string]interface{})
+			if findEndpointIpAddress(oldList, address) != nil {
+				continue
+			}
+			opts := EndpointIpAddressOpts{
+				SubnetID: address["subnet_id"].(string),
+				IP:       address["ip"].(string),
+			}
+			if err := AddEndpointIpAddress(client, d.Id(), opts); err != nil {
+				return fmterr.Errorf("error adding IP address to OpenTelekomCloud DNS endpoint (%s): %s", d.Id(), logHttpError(err))
+			}
+			if err := waitForDNSEndpointActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, o := range oldList {
+			address := o.(map[string]interface{})
+			if findEndpointIpAddress(newList, address) != nil {
+				continue
+			}
+			if err := RemoveEndpointIpAddress(client, d.Id(), address["ip_address_id"].(string)); err != nil {
+				return fmterr.Errorf("error removing IP address from OpenTelekomCloud DNS endpoint (%s): %s", d.Id(), logHttpError(err))
+			}
+			if err := waitForDNSEndpointActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV21)
+	return resourceDNSResolverEndpointV2Read(clientCtx, d, meta)
+}
+
+func resourceDNSResolverEndpointV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV21, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV21Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV21Client, err)
+	}
+
+	if err := DeleteEndpoint(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "DNS endpoint")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"DELETED"},
+		Pending:      []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:      waitForDNSEndpoint(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DNS endpoint (%s) to delete: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// findEndpointIpAddress searches the address with the same subnet and IP, empty IP matches any
+func findEndpointIpAddress(addresses []interface{}, address map[string]interface{}) map[string]interface{} {
+	for _, a := range addresses {
+		candidate := a.(map[string]interface{})
+		if candidate["subnet_id"] != address["subnet_id"] {
+			continue
+		}
+		if address["ip"].(string) == "" || candidate["ip"].(string) == "" || candidate["ip"] == address["ip"] {
+			return candidate
+		}
+	}
+	return nil
+}
+
+func waitForDNSEndpointActive(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for DNS endpoint (%s) to become ACTIVE", id)
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"ACTIVE"},
+		Pending:      []string{"PENDING"},
+		Refresh:      waitForDNSEndpoint(client, id),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for DNS endpoint (%s) to become ACTIVE: %w", id, err)
+	}
+	return nil
+}
+
+func waitForDNSEndpoint(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		endpoint, err := GetEndpoint(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return endpoint, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenTelekomCloud DNS endpoint (%s) current status: %s", id, endpoint.Status)
+		return endpoint, parseStatus(endpoint.Status), nil
+	}
+}
@@ -0,0 +1,376 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDNSResolverRuleV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSResolverRuleV2Create,
+		ReadContext:   resourceDNSResolverRuleV2Read,
+		UpdateContext: resourceDNSResolverRuleV2Update,
+		DeleteContext: resourceDNSResolverRuleV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"domain_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: common.SuppressEqualZoneNames,
+			},
+			"endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_addresses": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 6,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+			"router": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"router_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"router_region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandResolverRuleIpAddresses(d *schema.ResourceData) []RuleIpAddress {
+	raw := d.Get("ip_addresses").(*schema.Set).List()
+	res := make([]RuleIpAddress, len(raw))
+	for i, ip := range raw {
+		res[i] = RuleIpAddress{IP: ip.(string)}
+	}
+	return res
+}
+
+func expandResolverRuleRouters(raw []interface{}) []ResolverRuleRouter {
+	res := make([]ResolverRuleRouter, len(raw))
+	for i, v := range raw {
+		router := v.(map[string]interface{})
+		res[i] = ResolverRuleRouter{
+			RouterID:     router["router_id"].(string),
+			RouterRegion: router["router_region"].(string),
+		}
+	}
+	return res
+}
+
+func resourceDNSResolverRuleV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV21, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV21Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV21Client, err)
+	}
+
+	opts := CreateResolverRuleOpts{
+		Name:        d.Get("name").(string),
+		DomainName:  d.Get("domain_name").(string),
+		EndpointID:  d.Get("endpoint_id").(string),
+		IpAddresses: expandResolverRuleIpAddresses(d),
+	}
+	log.Printf("[DEBUG] Create DNS resolver rule options: %#v", opts)
+	rule, err := CreateResolverRule(client, opts)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS resolver rule: %s", logHttpError(err))
+	}
+	d.SetId(rule.ID)
+
+	if err := waitForDNSResolverRuleActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, router := range expandResolverRuleRouters(d.Get("router").(*schema.Set).List()) {
+		if err := associateResolverRuleRouter(ctx, client, d.Id(), router, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV21)
+	return resourceDNSResolverRuleV2Read(clientCtx, d, meta)
+}
+
+func resourceDNSResolverRuleV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV21, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV21Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV21Client, err)
+	}
+
+	rule, err := GetResolverRule(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "DNS resolver rule")
+	}
+
+	ipAddresses := make([]string, len(rule.IpAddresses))
+	for i, address := range rule.IpAddresses {
+		ipAddresses[i] = address.IP
+	}
+	routers := make([]map[string]interface{}, len(rule.Routers))
+	for i, router := range rule.Routers {
+		routers[i] = map[string]interface{}{
+			"router_id":     router.RouterID,
+			"router_region": router.RouterRegion,
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", rule.Name),
+		d.Set("domain_name", rule.DomainName),
+		d.Set("endpoint_id", rule.EndpointID),
+		d.Set("ip_addresses", ipAddresses),
+		d.Set("router", routers),
+		d.Set("status", rule.Status),
+		d.Set("rule_type", rule.RuleType),
+		d.Set("created_at", rule.CreatedAt),
+		d.Set("updated_at", rule.UpdatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DNS resolver rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDNSResolverRuleV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV21, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV21Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV21Client, err)
+	}
+
+	if d.HasChanges("name", "ip_addresses") {
+		opts := UpdateResolverRuleOpts{
+			Name:        d.Get("name").(string),
+			IpAddresses: expandResolverRuleIpAddresses(d),
+		}
+		if err := UpdateResolverRule(client, d.Id(), opts); err != nil {
+			return fmterr.Errorf("error updating OpenTelekomCloud DNS resolver rule: %s", logHttpError(err))
+		}
+		if err := waitForDNSResolverRuleActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("router") {
+		oldRaw, newRaw := d.GetChange("router")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+		for _, router := range expandResolverRuleRouters(oldSet.Difference(newSet).List()) {
+			if err := disassociateResolverRuleRouter(ctx, client, d.Id(), router, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, router := range expandResolverRuleRouters(newSet.Difference(oldSet).List()) {
+			if err := associateResolverRuleRouter(ctx, client, d.Id(), router, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV21)
+	return resourceDNSResolverRuleV2Read(clientCtx, d, meta)
+}
+
+func resourceDNSResolverRuleV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV21, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV21Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV21Client, err)
+	}
+
+	// the rule can't be deleted while it is associated with any VPC
+	for _, router := range expandResolverRuleRouters(d.Get("router").(*schema.Set).List()) {
+		if err := disassociateResolverRuleRouter(ctx, client, d.Id(), router, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := DeleteResolverRule(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "DNS resolver rule")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"DELETED"},
+		Pending:      []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:      waitForDNSResolverRule(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DNS resolver rule (%s) to delete: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func associateResolverRuleRouter(ctx context.Context, client *golangsdk.ServiceClient, id string, router ResolverRuleRouter, timeout time.Duration) error {
+	log.Printf("[DEBUG] Associating DNS resolver rule (%s) with router: %#v", id, router)
+	if err := AssociateResolverRuleRouter(client, id, router); err != nil {
+		return fmt.Errorf("error associating DNS resolver rule (%s) with router (%s): %s", id, router.RouterID, logHttpError(err))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"ACTIVE"},
+		Pending:      []string{"PENDING"},
+		Refresh:      waitForDNSResolverRuleRouter(client, id, router.RouterID),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for DNS resolver rule (%s) association with router (%s) to become ACTIVE: %w",
+			id, router.RouterID, err)
+	}
+	return nil
+}
+
+func disassociateResolverRuleRouter(ctx context.Context, client *golangsdk.ServiceClient, id string, router ResolverRuleRouter, timeout time.Duration) error {
+	log.Printf("[DEBUG] Disassociating DNS resolver rule (%s) from router: %#v", id, router)
+	if err := DisassociateResolverRuleRouter(client, id, router); err != nil {
+		return fmt.Errorf("error disassociating DNS resolver rule (%s) from router (%s): %s", id, router.RouterID, logHttpError(err))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"DELETED"},
+		Pending:      []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:      waitForDNSResolverRuleRouter(client, id, router.RouterID),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for DNS resolver rule (%s) disassociation from router (%s): %w",
+			id, router.RouterID, err)
+	}
+	return nil
+}
+
+func waitForDNSResolverRuleActive(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for DNS resolver rule (%s) to become ACTIVE", id)
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"ACTIVE"},
+		Pending:      []string{"PENDING"},
+		Refresh:      waitForDNSResolverRule(client, id),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for DNS resolver rule (%s) to become ACTIVE: %w", id, err)
+	}
+	return nil
+}
+
+func waitForDNSResolverRule(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := GetResolverRule(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return rule, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenTelekomCloud DNS resolver rule (%s) current status: %s", id, rule.Status)
+		return rule, parseStatus(rule.Status), nil
+	}
+}
+
+func waitForDNSResolverRuleRouter(client *golangsdk.ServiceClient, id, routerID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := GetResolverRule(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, router := range rule.Routers {
+			if router.RouterID == routerID {
+				log.Printf("[DEBUG] OpenTelekomCloud DNS resolver rule (%s) router (%s) current status: %s",
+					id, routerID, router.Status)
+				return rule, parseStatus(router.Status), nil
+			}
+		}
+		return rule, "DELETED", nil
+	}
+}
@@ -0,0 +1,195 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zones"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceDNSZoneAssociationV2 associates the private zone with a VPC
+// independently of the zone `router` configuration.
+func ResourceDNSZoneAssociationV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneAssociationV2Create,
+		ReadContext:   resourceDNSZoneAssociationV2Read,
+		DeleteContext: resourceDNSZoneAssociationV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSZoneAssociationV2ImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"router_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSZoneAssociationV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	routerOpts := zones.RouterOpts{
+		RouterID:     d.Get("router_id").(string),
+		RouterRegion: d.Get("router_region").(string),
+	}
+	if routerOpts.RouterRegion == "" {
+		routerOpts.RouterRegion = config.GetRegion(d)
+	}
+
+	zoneMutexKV.Lock(zoneID)
+	defer zoneMutexKV.Unlock(zoneID)
+
+	log.Printf("[DEBUG] Associating DNS zone (%s) with router: %#v", zoneID, routerOpts)
+	if _, err := zones.AssociateZone(client, zoneID, routerOpts).Extract(); err != nil {
+		return fmterr.Errorf("error associating DNS zone (%s) with router (%s): %s", zoneID, routerOpts.RouterID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", zoneID, routerOpts.RouterID))
+
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"ACTIVE"},
+		Pending:      []string{"PENDING"},
+		Refresh:      waitForDNSZoneRouter(client, zoneID, routerOpts.RouterID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DNS zone (%s) association with router (%s) to become ACTIVE: %s",
+			zoneID, routerOpts.RouterID, err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceDNSZoneAssociationV2Read(clientCtx, d, meta)
+}
+
+func resourceDNSZoneAssociationV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	routerID := d.Get("router_id").(string)
+
+	zone, err := zones.Get(client, zoneID).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "DNS zone association")
+	}
+
+	for _, router := range zone.Routers {
+		if router.RouterID != routerID {
+			continue
+		}
+		mErr := multierror.Append(nil,
+			d.Set("router_region", router.RouterRegion),
+			d.Set("status", router.Status),
+		)
+		if err := mErr.ErrorOrNil(); err != nil {
+			return fmterr.Errorf("error setting DNS zone association fields: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Router (%s) is not associated with DNS zone (%s), removing from state", routerID, zoneID)
+	d.SetId("")
+	return nil
+}
+
+func resourceDNSZoneAssociationV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	routerOpts := zones.RouterOpts{
+		RouterID:     d.Get("router_id").(string),
+		RouterRegion: d.Get("router_region").(string),
+	}
+
+	zoneMutexKV.Lock(zoneID)
+	defer zoneMutexKV.Unlock(zoneID)
+
+	if _, err := zones.DisassociateZone(client, zoneID, routerOpts).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "DNS zone association")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"DELETED"},
+		Pending:      []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:      waitForDNSZoneRouter(client, zoneID, routerOpts.RouterID),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DNS zone (%s) disassociation from router (%s): %s",
+			zoneID, routerOpts.RouterID, err)
+	}
+
+	return nil
+}
+
+func resourceDNSZoneAssociationV2ImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<zone_id>/<router_id>', but '%s'", d.Id())
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("zone_id", parts[0]),
+		d.Set("router_id", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
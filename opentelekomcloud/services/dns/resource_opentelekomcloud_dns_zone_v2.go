@@ -470,7 +470,10 @@ func waitForDNSZone(client *golangsdk.ServiceClient, zoneId string) resource.Sta
 }
 
 func getDNSRouters(d *schema.ResourceData) []zones.RouterOpts {
-	router := d.Get("router").(*schema.Set).List()
+	return expandDNSRouters(d.Get("router").(*schema.Set).List())
+}
+
+func expandDNSRouters(router []interface{}) []zones.RouterOpts {
 	if len(router) > 0 {
 		res := make([]zones.RouterOpts, len(router))
 		for i := range router {
@@ -540,22 +543,30 @@ func resourceGetDNSRouters(client *golangsdk.ServiceClient, d *schema.ResourceDa
 		i++
 	}
 
-	// get disassociateMap
+	// get disassociateMap, only routers removed from the `router` are disassociated,
+	// routers associated out of the zone resource (e.g. by `opentelekomcloud_dns_zone_association_v2`) are kept
+	oldRouter, _ := d.GetChange("router")
 	disassociateMap := make(map[string]zones.RouterOpts)
-	for _, raw := range n.Routers {
-		// Check if api is found in local
+	for _, old := range expandDNSRouters(oldRouter.(*schema.Set).List()) {
+		// Check if old is still found in local
 		found := false
 		for _, local := range localRouters {
-			if raw.RouterID == local.RouterID {
+			if old.RouterID == local.RouterID {
 				found = true
 				break
 			}
 		}
-		// If api is not found in local
-		if !found {
-			disassociateMap[raw.RouterID] = zones.RouterOpts{
-				RouterID:     raw.RouterID,
-				RouterRegion: raw.RouterRegion,
+		if found {
+			continue
+		}
+		// Check if old is still associated in api
+		for _, raw := range n.Routers {
+			if old.RouterID == raw.RouterID {
+				disassociateMap[raw.RouterID] = zones.RouterOpts{
+					RouterID:     raw.RouterID,
+					RouterRegion: raw.RouterRegion,
+				}
+				break
 			}
 		}
 	}
@@ -0,0 +1,154 @@
+package dws
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dws/v1/cluster"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dws/v1/snapshot"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceDwsClusterRestoreV1 manages a cluster restored from a snapshot.
+// Read and delete are shared with the regular cluster resource.
+func ResourceDwsClusterRestoreV1() *schema.Resource {
+	clusterSchema := ResourceDcsInstanceV1().Schema
+
+	return &schema.Resource{
+		CreateContext: resourceDwsClusterRestoreV1Create,
+		ReadContext:   resourceDwsClusterV1Read,
+		DeleteContext: resourceDwsClusterV1Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(4, 64),
+					validation.StringMatch(
+						regexp.MustCompile(`^[\-_A-Za-z0-9]+$`),
+						"Only letters, digits, underscores (_), and hyphens (-) are allowed.",
+					),
+				),
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(8000, 30000),
+			},
+			"public_ip":                 clusterSchema["public_ip"],
+			"keep_last_manual_snapshot": clusterSchema["keep_last_manual_snapshot"],
+			"user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"number_of_node": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created":          clusterSchema["created"],
+			"endpoints":        computedOnly(clusterSchema["endpoints"]),
+			"public_endpoints": computedOnly(clusterSchema["public_endpoints"]),
+			"recent_event":     clusterSchema["recent_event"],
+			"status":           clusterSchema["status"],
+			"sub_status":       clusterSchema["sub_status"],
+			"task_status":      clusterSchema["task_status"],
+			"updated":          clusterSchema["updated"],
+			"version":          clusterSchema["version"],
+			"private_ip":       clusterSchema["private_ip"],
+		},
+	}
+}
+
+func resourceDwsClusterRestoreV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	restoreOpts := snapshot.RestoreClusterOpts{
+		SnapshotId:       d.Get("snapshot_id").(string),
+		Name:             d.Get("name").(string),
+		SubnetId:         d.Get("network_id").(string),
+		SecurityGroupId:  d.Get("security_group_id").(string),
+		VpcId:            d.Get("vpc_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		Port:             d.Get("port").(int),
+	}
+	if _, ok := d.GetOk("public_ip.0"); ok {
+		restoreOpts.PublicIp = cluster.PublicIp{
+			PublicBindType: d.Get("public_ip.0.public_bind_type").(string),
+			EipId:          d.Get("public_ip.0.eip_id").(string),
+		}
+	}
+	log.Printf("[DEBUG] Restore Options: %#v", restoreOpts)
+
+	clusterID, err := snapshot.RestoreCluster(client, restoreOpts)
+	if err != nil {
+		return fmterr.Errorf("error restoring DWS cluster from snapshot: %w", err)
+	}
+	d.SetId(clusterID)
+
+	timeout := int(d.Timeout(schema.TimeoutCreate) / time.Second)
+	if err := snapshot.WaitForRestore(client, clusterID, timeout); err != nil {
+		return fmterr.Errorf("error waiting for cluster (%s) to be restored: %w", clusterID, err)
+	}
+
+	return resourceDwsClusterV1Read(ctx, d, meta)
+}
+
+func computedOnly(s *schema.Schema) *schema.Schema {
+	c := *s
+	c.Optional = false
+	c.Computed = true
+	return &c
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,6 +31,11 @@ func ResourceDcsInstanceV1() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.ForceNewIfChange("number_of_node", func(_ context.Context, old, new, _ interface{}) bool {
+			// a cluster can only be scaled out in place
+			return new.(int) < old.(int)
+		}),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -272,7 +278,7 @@ func resourceDwsClusterV1Read(_ context.Context, d *schema.ResourceData, meta in
 
 	v, err := cluster.ListClusterDetails(client, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return common.CheckDeletedDiag(d, err, "DWS cluster")
 	}
 
 	log.Printf("[DEBUG] DWS cluster %s: %+v", d.Id(), v)
@@ -366,7 +372,7 @@ func resourceDwsClusterV1Update(ctx context.Context, d *schema.ResourceData, met
 			Refresh:      dwsClusterV1StateRefreshFuncUpdate(client, d.Id(), true),
 			Timeout:      d.Timeout(schema.TimeoutUpdate),
 			Delay:        10 * time.Second,
-			PollInterval: 20 * d.Timeout(schema.TimeoutUpdate),
+			PollInterval: 20 * time.Second,
 		}
 
 		_, err = stateConf.WaitForStateContext(ctx)
@@ -393,7 +399,7 @@ func resourceDwsClusterV1Update(ctx context.Context, d *schema.ResourceData, met
 			Refresh:      dwsClusterV1StateRefreshFuncUpdate(client, d.Id(), false),
 			Timeout:      d.Timeout(schema.TimeoutUpdate),
 			Delay:        10 * time.Second,
-			PollInterval: 20 * d.Timeout(schema.TimeoutUpdate),
+			PollInterval: 20 * time.Second,
 		}
 
 		_, err = stateConf.WaitForStateContext(ctx)
@@ -0,0 +1,247 @@
+package dws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDwsSnapshotPolicyV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDwsSnapshotPolicyV1Create,
+		ReadContext:   resourceDwsSnapshotPolicyV1Read,
+		UpdateContext: resourceDwsSnapshotPolicyV1Update,
+		DeleteContext: resourceDwsSnapshotPolicyV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("cluster_id"),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"keep_day": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 31),
+			},
+			"strategy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"cron": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"full", "increment",
+							}, false),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_fire_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDwsSnapshotPolicyV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	if err := updateDwsSnapshotPolicy(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error creating DWS snapshot policy: %w", err)
+	}
+	d.SetId(clusterId)
+
+	return resourceDwsSnapshotPolicyV1Read(ctx, d, meta)
+}
+
+func resourceDwsSnapshotPolicyV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	policy, err := GetSnapshotPolicy(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "DWS snapshot policy")
+	}
+	if len(policy.BackupStrategies) == 0 {
+		log.Printf("[WARN] DWS cluster %s has no snapshot strategies, removing from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	strategies := make([]map[string]interface{}, 0, len(policy.BackupStrategies))
+	for _, s := range policy.BackupStrategies {
+		strategies = append(strategies, map[string]interface{}{
+			"id":             s.PolicyId,
+			"name":           s.PolicyName,
+			"cron":           s.BackupStrategy,
+			"type":           s.BackupType,
+			"next_fire_time": s.NextFireTime,
+		})
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("cluster_id", d.Id()),
+		d.Set("keep_day", policy.KeepDay),
+		d.Set("strategy", strategies),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDwsSnapshotPolicyV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if d.HasChange("strategy") {
+		// strategies are matched by name, the removed ones have to be deleted explicitly
+		oldRaw, newRaw := d.GetChange("strategy")
+		kept := make(map[string]struct{})
+		for _, s := range expandDwsSnapshotStrategies(newRaw.([]interface{})) {
+			kept[s.PolicyName] = struct{}{}
+		}
+		for _, raw := range oldRaw.([]interface{}) {
+			s := raw.(map[string]interface{})
+			if _, ok := kept[s["name"].(string)]; ok || s["id"].(string) == "" {
+				continue
+			}
+			if err := DeleteSnapshotStrategy(client, d.Id(), s["id"].(string)); err != nil {
+				return fmterr.Errorf("error deleting DWS snapshot strategy %s: %w", s["name"], err)
+			}
+		}
+	}
+
+	if d.HasChanges("keep_day", "strategy") {
+		if err := updateDwsSnapshotPolicy(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error updating DWS snapshot policy: %w", err)
+		}
+	}
+
+	return resourceDwsSnapshotPolicyV1Read(ctx, d, meta)
+}
+
+func resourceDwsSnapshotPolicyV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	policy, err := GetSnapshotPolicy(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "DWS snapshot policy")
+	}
+	for _, s := range policy.BackupStrategies {
+		if err := DeleteSnapshotStrategy(client, d.Id(), s.PolicyId); err != nil {
+			return common.CheckDeletedDiag(d, err, "error deleting DWS snapshot strategy")
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"DONE"},
+		Refresh:      dwsClusterV1StateRefreshFuncUpdate(client, d.Id(), false),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for cluster (%s) to update: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateDwsSnapshotPolicy(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, timeout time.Duration) error {
+	clusterId := d.Get("cluster_id").(string)
+	opts := SnapshotPolicyOpts{
+		KeepDay:          d.Get("keep_day").(int),
+		BackupStrategies: expandDwsSnapshotStrategies(d.Get("strategy").([]interface{})),
+	}
+	log.Printf("[DEBUG] Snapshot Policy Options: %#v", opts)
+
+	if err := UpdateSnapshotPolicy(client, clusterId, opts); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"DONE"},
+		Refresh:      dwsClusterV1StateRefreshFuncUpdate(client, clusterId, false),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func expandDwsSnapshotStrategies(raw []interface{}) []SnapshotStrategy {
+	strategies := make([]SnapshotStrategy, 0, len(raw))
+	for _, v := range raw {
+		s := v.(map[string]interface{})
+		strategies = append(strategies, SnapshotStrategy{
+			PolicyName:     s["name"].(string),
+			BackupStrategy: s["cron"].(string),
+			BackupType:     s["type"].(string),
+			BackupLevel:    "cluster",
+		})
+	}
+	return strategies
+}
@@ -0,0 +1,156 @@
+package dws
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dws/v1/snapshot"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDwsSnapshotV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDwsSnapshotV1Create,
+		ReadContext:   resourceDwsSnapshotV1Read,
+		DeleteContext: resourceDwsSnapshotV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(4, 64),
+					validation.StringMatch(
+						regexp.MustCompile(`^[A-Za-z][\-_A-Za-z0-9]+$`),
+						"Must start with a letter. Only letters, digits, underscores (_), and hyphens (-) are allowed.",
+					),
+				),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"started": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"finished": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDwsSnapshotV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	createOpts := snapshot.Snapshot{
+		Name:        d.Get("name").(string),
+		ClusterId:   d.Get("cluster_id").(string),
+		Description: d.Get("description").(string),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	id, err := snapshot.CreateSnapshot(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating DWS snapshot: %w", err)
+	}
+	d.SetId(id)
+
+	timeout := int(d.Timeout(schema.TimeoutCreate) / time.Second)
+	if err := snapshot.WaitForSnapshot(client, createOpts.ClusterId, id, timeout); err != nil {
+		return fmterr.Errorf("error waiting for DWS snapshot (%s) to become available: %w", id, err)
+	}
+
+	return resourceDwsSnapshotV1Read(ctx, d, meta)
+}
+
+func resourceDwsSnapshotV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	v, err := snapshot.ListSnapshotDetails(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "DWS snapshot")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("cluster_id", v.ClusterId),
+		d.Set("name", v.Name),
+		d.Set("description", v.Description),
+		d.Set("status", v.Status),
+		d.Set("type", v.Type),
+		d.Set("size", v.Size),
+		d.Set("started", v.Started),
+		d.Set("finished", v.Finished),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDwsSnapshotV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if err := snapshot.DeleteSnapshot(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DWS snapshot")
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package dws
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// The DWS automated snapshot policy APIs are not covered by gophertelekomcloud yet.
+
+type SnapshotPolicyOpts struct {
+	// Retention days of the automated snapshots. The value ranges from 1 to 31.
+	KeepDay int `json:"keep_day" required:"true"`
+	// Snapshot strategies of the cluster.
+	BackupStrategies []SnapshotStrategy `json:"backup_strategies" required:"true"`
+}
+
+type SnapshotStrategy struct {
+	// Strategy ID, set by the service.
+	PolicyId string `json:"policy_id,omitempty"`
+	// Strategy name.
+	PolicyName string `json:"policy_name"`
+	// Cron expression of the strategy, e.g. `0 8 6 * * ?`.
+	BackupStrategy string `json:"backup_strategy"`
+	// Snapshot type: `full` or `increment`.
+	BackupType string `json:"backup_type"`
+	// Snapshot level. Only `cluster` is supported.
+	BackupLevel string `json:"backup_level,omitempty"`
+	// Next time the strategy is triggered.
+	NextFireTime string `json:"next_fire_time,omitempty"`
+	// Last update time of the strategy.
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+func UpdateSnapshotPolicy(client *golangsdk.ServiceClient, clusterId string, opts SnapshotPolicyOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v1.0/{project_id}/clusters/{cluster_id}/snapshot-policies
+	_, err = client.Put(client.ServiceURL("clusters", clusterId, "snapshot-policies"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+type SnapshotPolicy struct {
+	KeepDay          int                `json:"keep_day"`
+	BackupStrategies []SnapshotStrategy `json:"backup_strategies"`
+}
+
+func GetSnapshotPolicy(client *golangsdk.ServiceClient, clusterId string) (*SnapshotPolicy, error) {
+	// GET /v1.0/{project_id}/clusters/{cluster_id}/snapshot-policies
+	var res SnapshotPolicy
+	_, err := client.Get(client.ServiceURL("clusters", clusterId, "snapshot-policies"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func DeleteSnapshotStrategy(client *golangsdk.ServiceClient, clusterId, policyId string) error {
+	// DELETE /v1.0/{project_id}/clusters/{cluster_id}/snapshot-policies/{id}
+	_, err := client.Delete(client.ServiceURL("clusters", clusterId, "snapshot-policies", policyId), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
@@ -0,0 +1,584 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	v3listeners "github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/listeners"
+	v1subnets "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/subnets"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const (
+	sourceLoadBalancer = "opentelekomcloud_lb_loadbalancer_v2"
+	sourceListener     = "opentelekomcloud_lb_listener_v2"
+	sourcePool         = "opentelekomcloud_lb_pool_v2"
+	sourceMember       = "opentelekomcloud_lb_member_v2"
+	sourceMonitor      = "opentelekomcloud_lb_monitor_v2"
+	sourceL7Policy     = "opentelekomcloud_lb_l7policy_v2"
+	sourceL7Rule       = "opentelekomcloud_lb_l7rule_v2"
+	sourceWhitelist    = "opentelekomcloud_lb_whitelist_v2"
+)
+
+func DataSourceLBV2ToV3Plan() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBV2ToV3PlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"l4_flavor": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"l7_flavor": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arguments": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"unsupported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attribute": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"hcl": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBV2ToV3PlanRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	client, err := config.ElbV2Client(region)
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV2Client, err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+	lb, err := loadbalancers.Get(client, lbID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving ELBv2 LoadBalancer %s: %w", lbID, err)
+	}
+
+	plan := newMigrationPlan()
+	if err := planLoadBalancer(plan, d, config, client, lb); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resources := make([]map[string]interface{}, len(plan.Resources))
+	for i, resource := range plan.Resources {
+		arguments := map[string]string{}
+		flattenArguments(resource.Arguments, "", arguments)
+		resources[i] = map[string]interface{}{
+			"type":        resource.Type,
+			"name":        resource.Name,
+			"source_type": resource.SourceType,
+			"source_id":   resource.SourceID,
+			"arguments":   arguments,
+		}
+	}
+	issues := make([]map[string]interface{}, len(plan.Issues))
+	for i, issue := range plan.Issues {
+		issues[i] = map[string]interface{}{
+			"source_type": issue.SourceType,
+			"source_id":   issue.SourceID,
+			"attribute":   issue.Attribute,
+			"message":     issue.Message,
+		}
+	}
+
+	d.SetId(lbID)
+
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("resources", resources),
+		d.Set("unsupported", issues),
+		d.Set("hcl", plan.HCL()),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func planLoadBalancer(plan *migrationPlan, d *schema.ResourceData, config *cfg.Config, client *golangsdk.ServiceClient, lb *loadbalancers.LoadBalancer) error {
+	region := config.GetRegion(d)
+	nwClient, err := config.NetworkingV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+	vpcClient, err := config.NetworkingV1Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	vipSubnet, err := subnets.Get(nwClient, lb.VipSubnetID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving VIP subnet %s: %w", lb.VipSubnetID, err)
+	}
+	vpcSubnet, err := v1subnets.Get(vpcClient, vipSubnet.NetworkID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving VPC subnet %s: %w", vipSubnet.NetworkID, err)
+	}
+
+	planLB := plan.addResource("opentelekomcloud_lb_loadbalancer_v3", sourceLoadBalancer, lb.ID, lb.Name)
+	planLB.addString("name", lb.Name)
+	planLB.addString("description", lb.Description)
+	planLB.add("router_id", vpcSubnet.VpcID)
+	planLB.add("subnet_id", lb.VipSubnetID)
+	if azs := common.ExpandToStringSlice(d.Get("availability_zones").([]interface{})); len(azs) != 0 {
+		planLB.add("availability_zones", azs)
+	} else {
+		planLB.add("availability_zones", planRef("var.availability_zones"))
+	}
+	planLB.addString("l4_flavor", d.Get("l4_flavor").(string))
+	planLB.addString("l7_flavor", d.Get("l7_flavor").(string))
+	if lb.VipAddress != "" {
+		plan.flag(sourceLoadBalancer, lb.ID, "vip_address",
+			"VIP address %s is used by the shared load balancer and can't be reused until it is deleted", lb.VipAddress)
+	}
+
+	fips, err := listFloatingIPs(nwClient, lb.VipPortID)
+	if err != nil {
+		return err
+	}
+	for _, fip := range fips {
+		plan.flag(sourceLoadBalancer, lb.ID, "vip_port_id",
+			"floating IP %s (%s) is bound to the VIP port and has to be rebound to the dedicated load balancer", fip.FloatingIP, fip.ID)
+	}
+
+	// backend subnets have to be listed explicitly for the dedicated load balancer
+	networkIDs := []string{vipSubnet.NetworkID}
+
+	listenerList, err := listListeners(client, lb.ID)
+	if err != nil {
+		return err
+	}
+	poolList, err := listPools(client, lb.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, listener := range listenerList {
+		if err := planListener(plan, config, region, client, planLB, listener); err != nil {
+			return err
+		}
+	}
+
+	pooledListeners := map[string]bool{}
+	for _, pool := range poolList {
+		planPool := plan.addResource("opentelekomcloud_lb_pool_v3", sourcePool, pool.ID, pool.Name)
+		planPool.addString("name", pool.Name)
+		planPool.addString("description", pool.Description)
+		planPool.add("protocol", pool.Protocol)
+		planPool.add("lb_algorithm", pool.LBMethod)
+		if len(pool.Listeners) != 0 {
+			planPool.add("listener_id", plan.refOrID(pool.Listeners[0].ID))
+			pooledListeners[pool.Listeners[0].ID] = true
+		} else {
+			planPool.add("loadbalancer_id", planLB.ref())
+		}
+		if pool.Persistence.Type != "" {
+			persistence := []planArgument{{Key: "type", Value: pool.Persistence.Type}}
+			if pool.Persistence.CookieName != "" {
+				persistence = append(persistence, planArgument{Key: "cookie_name", Value: pool.Persistence.CookieName})
+			}
+			planPool.add("session_persistence", persistence)
+		}
+		if !pool.AdminStateUp {
+			plan.flag(sourcePool, pool.ID, "admin_state_up",
+				"dedicated load balancer pools can't be disabled")
+		}
+
+		if pool.MonitorID != "" {
+			monitor, err := monitors.Get(client, pool.MonitorID).Extract()
+			if err != nil {
+				return fmt.Errorf("error retrieving ELBv2 monitor %s: %w", pool.MonitorID, err)
+			}
+			planMonitor(plan, planPool, monitor)
+		}
+
+		memberNetworkIDs, err := planMembers(plan, client, nwClient, planPool, pool.ID)
+		if err != nil {
+			return err
+		}
+		for _, networkID := range memberNetworkIDs {
+			if !common.StringInSlice(networkID, networkIDs) {
+				networkIDs = append(networkIDs, networkID)
+			}
+		}
+	}
+
+	// listeners with the pool not bound to them directly refer to the pool by `default_pool_id`
+	for _, listener := range listenerList {
+		if listener.DefaultPoolID == "" || pooledListeners[listener.ID] {
+			continue
+		}
+		if planned, ok := plan.bySource[listener.ID]; ok {
+			planned.add("default_pool_id", plan.refOrID(listener.DefaultPoolID))
+		}
+	}
+
+	for _, listener := range listenerList {
+		if err := planL7Policies(plan, client, listener.ID); err != nil {
+			return err
+		}
+	}
+
+	planLB.add("network_ids", networkIDs)
+
+	lbTags, err := tags.Get(client, "loadbalancers", lb.ID).Extract()
+	if err != nil {
+		return fmt.Errorf("error fetching ELBv2 LoadBalancer tags: %w", err)
+	}
+	if len(lbTags) != 0 {
+		planLB.add("tags", common.TagsToMap(lbTags))
+	}
+	return nil
+}
+
+func planListener(plan *migrationPlan, config *cfg.Config, region string, client *golangsdk.ServiceClient, planLB *planResource, listener listeners.Listener) error {
+	planned := plan.addResource("opentelekomcloud_lb_listener_v3", sourceListener, listener.ID, listener.Name)
+	planned.addString("name", listener.Name)
+	planned.addString("description", listener.Description)
+	protocol := listener.Protocol
+	if protocol == "TERMINATED_HTTPS" {
+		protocol = "HTTPS"
+	}
+	planned.add("protocol", protocol)
+	planned.add("protocol_port", listener.ProtocolPort)
+	planned.add("loadbalancer_id", planLB.ref())
+	if listener.Http2Enable {
+		planned.add("http2_enable", true)
+	}
+	planned.addString("tls_ciphers_policy", listener.TlsCiphersPolicy)
+
+	// certificates of the shared load balancers can't be used by the dedicated ones
+	if listener.DefaultTlsContainerRef != "" {
+		planned.add("default_tls_container_ref", listener.DefaultTlsContainerRef)
+		plan.flag(sourceListener, listener.ID, "default_tls_container_ref",
+			"certificate %s has to be recreated as opentelekomcloud_lb_certificate_v3", listener.DefaultTlsContainerRef)
+	}
+	if listener.CAContainerRef != "" {
+		planned.add("client_ca_tls_container_ref", listener.CAContainerRef)
+		plan.flag(sourceListener, listener.ID, "client_ca_tls_container_ref",
+			"certificate %s has to be recreated as opentelekomcloud_lb_certificate_v3", listener.CAContainerRef)
+	}
+	if len(listener.SniContainerRefs) != 0 {
+		planned.add("sni_container_refs", listener.SniContainerRefs)
+		plan.flag(sourceListener, listener.ID, "sni_container_refs",
+			"certificates %s have to be recreated as opentelekomcloud_lb_certificate_v3", strings.Join(listener.SniContainerRefs, ", "))
+	}
+
+	if listener.ConnLimit > 0 {
+		plan.flag(sourceListener, listener.ID, "connection_limit",
+			"connection limit %d is not supported by dedicated load balancers", listener.ConnLimit)
+	}
+	if !listener.AdminStateUp {
+		plan.flag(sourceListener, listener.ID, "admin_state_up",
+			"dedicated load balancer listeners can't be disabled")
+	}
+
+	// `transparent_client_ip_enable` and `ip_group` are available only via v3 API
+	v3Client, err := elbV3Client(config, region)
+	if err != nil {
+		return err
+	}
+	v3Listener, err := v3listeners.Get(v3Client, listener.ID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving v3 details of ELBv2 listener %s: %w", listener.ID, err)
+	}
+	if v3Listener.TransparentClientIP {
+		plan.flag(sourceListener, listener.ID, "transparent_client_ip_enable",
+			"dedicated load balancers always pass the client IP address to the backend servers")
+	}
+	var ipGroup []planArgument
+	if v3Listener.IpGroup.IpGroupID != "" {
+		ipGroup = []planArgument{
+			{Key: "id", Value: v3Listener.IpGroup.IpGroupID},
+			{Key: "enable", Value: v3Listener.IpGroup.Enable != nil && *v3Listener.IpGroup.Enable},
+			{Key: "type", Value: v3Listener.IpGroup.Type},
+		}
+	}
+
+	whitelistList, err := listWhitelists(client, listener.ID)
+	if err != nil {
+		return fmt.Errorf("error listing ELBv2 whitelists of listener %s: %w", listener.ID, err)
+	}
+	for _, whitelist := range whitelistList {
+		if ipGroup != nil {
+			plan.flag(sourceWhitelist, whitelist.ID, "whitelist",
+				"listener already uses IP group %s, whitelist can't be migrated", v3Listener.IpGroup.IpGroupID)
+			continue
+		}
+		planIPGroup := plan.addResource("opentelekomcloud_lb_ipgroup_v3", sourceWhitelist, whitelist.ID, listener.Name+"_whitelist")
+		planIPGroup.add("name", listener.Name+"_whitelist")
+		for _, ip := range strings.Split(whitelist.Whitelist, ",") {
+			if ip = strings.TrimSpace(ip); ip != "" {
+				planIPGroup.add("ip_list", []planArgument{{Key: "ip", Value: ip}})
+			}
+		}
+		ipGroup = []planArgument{
+			{Key: "id", Value: planIPGroup.ref()},
+			{Key: "enable", Value: whitelist.EnableWhitelist},
+			{Key: "type", Value: "white"},
+		}
+	}
+	if ipGroup != nil {
+		planned.add("ip_group", ipGroup)
+	}
+
+	listenerTags, err := tags.Get(client, "listeners", listener.ID).Extract()
+	if err != nil {
+		return fmt.Errorf("error fetching ELBv2 listener tags: %w", err)
+	}
+	if len(listenerTags) != 0 {
+		planned.add("tags", common.TagsToMap(listenerTags))
+	}
+	return nil
+}
+
+func planMonitor(plan *migrationPlan, planPool *planResource, monitor *monitors.Monitor) {
+	planned := plan.addResource("opentelekomcloud_lb_monitor_v3", sourceMonitor, monitor.ID, monitor.Name)
+	planned.add("pool_id", planPool.ref())
+	planned.addString("name", monitor.Name)
+	planned.add("type", monitor.Type)
+	planned.add("delay", monitor.Delay)
+	planned.add("timeout", monitor.Timeout)
+	planned.add("max_retries", monitor.MaxRetries)
+	planned.addString("url_path", monitor.URLPath)
+	planned.addString("http_method", monitor.HTTPMethod)
+	planned.addString("expected_codes", monitor.ExpectedCodes)
+	planned.addString("domain_name", monitor.DomainName)
+	if monitor.MonitorPort != 0 {
+		planned.add("monitor_port", monitor.MonitorPort)
+	}
+	if !monitor.AdminStateUp {
+		planned.add("admin_state_up", false)
+	}
+}
+
+// planMembers adds members of the pool to the plan and returns networks of the members
+func planMembers(plan *migrationPlan, client, nwClient *golangsdk.ServiceClient, planPool *planResource, poolID string) ([]string, error) {
+	pages, err := pools.ListMembers(client, poolID, pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing ELBv2 members of pool %s: %w", poolID, err)
+	}
+	memberList, err := pools.ExtractMembers(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting ELBv2 members: %w", err)
+	}
+
+	var networkIDs []string
+	subnetNetworks := map[string]string{}
+	for _, member := range memberList {
+		name := member.Name
+		if name == "" {
+			name = "member_" + member.Address
+		}
+		planMember := plan.addResource("opentelekomcloud_lb_member_v3", sourceMember, member.ID, name)
+		planMember.add("pool_id", planPool.ref())
+		planMember.addString("name", member.Name)
+		planMember.add("address", member.Address)
+		planMember.add("protocol_port", member.ProtocolPort)
+		planMember.add("subnet_id", member.SubnetID)
+		weight := member.Weight
+		if !member.AdminStateUp {
+			weight = 0
+			plan.flag(sourceMember, member.ID, "admin_state_up",
+				"dedicated load balancer members can't be disabled, weight is set to 0 instead")
+		}
+		planMember.add("weight", weight)
+
+		if _, ok := subnetNetworks[member.SubnetID]; ok || member.SubnetID == "" {
+			continue
+		}
+		subnet, err := subnets.Get(nwClient, member.SubnetID).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving member subnet %s: %w", member.SubnetID, err)
+		}
+		subnetNetworks[member.SubnetID] = subnet.NetworkID
+		networkIDs = append(networkIDs, subnet.NetworkID)
+	}
+	return networkIDs, nil
+}
+
+func planL7Policies(plan *migrationPlan, client *golangsdk.ServiceClient, listenerID string) error {
+	pages, err := l7policies.List(client, l7policies.ListOpts{ListenerID: listenerID}).AllPages()
+	if err != nil {
+		return fmt.Errorf("error listing ELBv2 L7 policies of listener %s: %w", listenerID, err)
+	}
+	policyList, err := l7policies.ExtractL7Policies(pages)
+	if err != nil {
+		return fmt.Errorf("error extracting ELBv2 L7 policies: %w", err)
+	}
+
+	for _, policy := range policyList {
+		if policy.Action != "REDIRECT_TO_POOL" && policy.Action != "REDIRECT_TO_LISTENER" {
+			plan.flag(sourceL7Policy, policy.ID, "action",
+				"action %s can't be migrated, policy is skipped", policy.Action)
+			continue
+		}
+		planPolicy := plan.addResource("opentelekomcloud_lb_policy_v3", sourceL7Policy, policy.ID, policy.Name)
+		planPolicy.addString("name", policy.Name)
+		planPolicy.addString("description", policy.Description)
+		planPolicy.add("action", policy.Action)
+		planPolicy.add("listener_id", plan.refOrID(listenerID))
+		if policy.Position != 0 {
+			planPolicy.add("position", int(policy.Position))
+		}
+		if policy.RedirectPoolID != "" {
+			planPolicy.add("redirect_pool_id", plan.refOrID(policy.RedirectPoolID))
+		}
+		if policy.RedirectListenerID != "" {
+			planPolicy.add("redirect_listener_id", plan.refOrID(policy.RedirectListenerID))
+		}
+		if !policy.AdminStateUp {
+			plan.flag(sourceL7Policy, policy.ID, "admin_state_up",
+				"dedicated load balancer policies can't be disabled")
+		}
+
+		rulePages, err := l7policies.ListRules(client, policy.ID, l7policies.ListRulesOpts{}).AllPages()
+		if err != nil {
+			return fmt.Errorf("error listing ELBv2 L7 rules of policy %s: %w", policy.ID, err)
+		}
+		ruleList, err := l7policies.ExtractRules(rulePages)
+		if err != nil {
+			return fmt.Errorf("error extracting ELBv2 L7 rules: %w", err)
+		}
+		for _, rule := range ruleList {
+			planRule := plan.addResource("opentelekomcloud_lb_rule_v3", sourceL7Rule, rule.ID, planPolicy.Name+"_"+strings.ToLower(rule.RuleType))
+			planRule.add("policy_id", planPolicy.ref())
+			planRule.add("type", rule.RuleType)
+			planRule.add("compare_type", rule.CompareType)
+			planRule.add("value", rule.Value)
+			if rule.Key != "" {
+				plan.flag(sourceL7Rule, rule.ID, "key",
+					"rule key %s is not supported by dedicated load balancer rules", rule.Key)
+			}
+			if rule.Invert {
+				plan.flag(sourceL7Rule, rule.ID, "invert",
+					"inverted rules are not supported by dedicated load balancers")
+			}
+		}
+	}
+	return nil
+}
+
+func listListeners(client *golangsdk.ServiceClient, lbID string) ([]listeners.Listener, error) {
+	pages, err := listeners.List(client, listeners.ListOpts{LoadbalancerID: lbID}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing ELBv2 listeners: %w", err)
+	}
+	listenerList, err := listeners.ExtractListeners(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting ELBv2 listeners: %w", err)
+	}
+	return listenerList, nil
+}
+
+func listPools(client *golangsdk.ServiceClient, lbID string) ([]pools.Pool, error) {
+	pages, err := pools.List(client, pools.ListOpts{LoadbalancerID: lbID}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing ELBv2 pools: %w", err)
+	}
+	poolList, err := pools.ExtractPools(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting ELBv2 pools: %w", err)
+	}
+	return poolList, nil
+}
+
+func listFloatingIPs(client *golangsdk.ServiceClient, portID string) ([]floatingips.FloatingIP, error) {
+	if portID == "" {
+		return nil, nil
+	}
+	pages, err := floatingips.List(client, floatingips.ListOpts{PortID: portID}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing floating IPs of port %s: %w", portID, err)
+	}
+	fips, err := floatingips.ExtractFloatingIPs(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting floating IPs: %w", err)
+	}
+	return fips, nil
+}
@@ -0,0 +1,227 @@
+package v2
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// planRef is a raw HCL expression, e.g. a reference to another planned resource
+type planRef string
+
+// planArgument is a single argument of the planned resource. Value is one of
+// string, planRef, int, bool, []string, map[string]string or []planArgument (nested block)
+type planArgument struct {
+	Key   string
+	Value interface{}
+}
+
+type planResource struct {
+	Type       string
+	Name       string
+	SourceType string
+	SourceID   string
+	Arguments  []planArgument
+}
+
+func (r *planResource) add(key string, value interface{}) {
+	r.Arguments = append(r.Arguments, planArgument{Key: key, Value: value})
+}
+
+// addString adds string argument only if it's not empty
+func (r *planResource) addString(key, value string) {
+	if value != "" {
+		r.add(key, value)
+	}
+}
+
+func (r *planResource) ref() planRef {
+	return planRef(fmt.Sprintf("%s.%s.id", r.Type, r.Name))
+}
+
+type planIssue struct {
+	SourceType string
+	SourceID   string
+	Attribute  string
+	Message    string
+}
+
+// migrationPlan is the v3 equivalent of the v2 load balancer tree
+type migrationPlan struct {
+	Resources []*planResource
+	Issues    []planIssue
+
+	// emitted `<type>.<name>` addresses
+	names map[string]bool
+	// planned resources by v2 resource ID
+	bySource map[string]*planResource
+}
+
+func newMigrationPlan() *migrationPlan {
+	return &migrationPlan{
+		names:    map[string]bool{},
+		bySource: map[string]*planResource{},
+	}
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// addResource adds new resource with the unique Terraform name based on the v2 resource name
+func (p *migrationPlan) addResource(resourceType, sourceType, sourceID, name string) *planResource {
+	baseName := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if baseName == "" {
+		baseName = strings.TrimSuffix(strings.TrimPrefix(resourceType, "opentelekomcloud_lb_"), "_v3")
+	}
+	if baseName[0] >= '0' && baseName[0] <= '9' {
+		baseName = "lb_" + baseName
+	}
+
+	// the suffixed name can be the base name of another resource, e.g. `lb`, `lb` and `lb_2`
+	uniqueName := baseName
+	for i := 2; p.names[resourceType+"."+uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s_%d", baseName, i)
+	}
+	p.names[resourceType+"."+uniqueName] = true
+
+	resource := &planResource{
+		Type:       resourceType,
+		Name:       uniqueName,
+		SourceType: sourceType,
+		SourceID:   sourceID,
+	}
+	p.Resources = append(p.Resources, resource)
+	if sourceID != "" {
+		p.bySource[sourceID] = resource
+	}
+	return resource
+}
+
+// refOrID returns reference to the planned resource migrated from v2 resource with given ID,
+// falling back to the ID itself if there is no such resource in the plan
+func (p *migrationPlan) refOrID(sourceID string) interface{} {
+	if resource, ok := p.bySource[sourceID]; ok {
+		return resource.ref()
+	}
+	return sourceID
+}
+
+func (p *migrationPlan) flag(sourceType, sourceID, attribute, message string, args ...interface{}) {
+	p.Issues = append(p.Issues, planIssue{
+		SourceType: sourceType,
+		SourceID:   sourceID,
+		Attribute:  attribute,
+		Message:    fmt.Sprintf(message, args...),
+	})
+}
+
+// HCL renders the plan as Terraform configuration
+func (p *migrationPlan) HCL() string {
+	b := &strings.Builder{}
+	for i, resource := range p.Resources {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "# migrated from %s %s\n", resource.SourceType, resource.SourceID)
+		fmt.Fprintf(b, "resource %q %q {\n", resource.Type, resource.Name)
+		writeHCLBody(b, resource.Arguments, "  ")
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+func writeHCLBody(b *strings.Builder, args []planArgument, indent string) {
+	// attributes go first with aligned `=` like `terraform fmt` does, nested blocks follow
+	var attributes, blocks []planArgument
+	width := 0
+	for _, arg := range args {
+		if _, ok := arg.Value.([]planArgument); ok {
+			blocks = append(blocks, arg)
+			continue
+		}
+		attributes = append(attributes, arg)
+		if len(arg.Key) > width {
+			width = len(arg.Key)
+		}
+	}
+	for _, arg := range attributes {
+		value := hclExpression(arg.Value)
+		value = strings.ReplaceAll(value, "\n", "\n"+indent)
+		fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, arg.Key, value)
+	}
+	for _, block := range blocks {
+		b.WriteString("\n")
+		fmt.Fprintf(b, "%s%s {\n", indent, block.Key)
+		writeHCLBody(b, block.Value.([]planArgument), indent+"  ")
+		fmt.Fprintf(b, "%s}\n", indent)
+	}
+}
+
+// hclExpression renders single-line (or map) HCL expression for the value
+func hclExpression(value interface{}) string {
+	switch v := value.(type) {
+	case planRef:
+		return string(v)
+	case string:
+		return hclQuote(v)
+	case int:
+		return strconv.Itoa(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case []string:
+		quoted := make([]string, len(v))
+		for i, item := range v {
+			quoted[i] = hclQuote(item)
+		}
+		return "[" + strings.Join(quoted, ", ") + "]"
+	case map[string]string:
+		keys := make([]string, 0, len(v))
+		width := 0
+		for key := range v {
+			keys = append(keys, key)
+			if len(hclQuote(key)) > width {
+				width = len(hclQuote(key))
+			}
+		}
+		sort.Strings(keys)
+		lines := []string{"{"}
+		for _, key := range keys {
+			// tag keys are not always valid identifiers
+			lines = append(lines, fmt.Sprintf("  %-*s = %s", width, hclQuote(key), hclQuote(v[key])))
+		}
+		lines = append(lines, "}")
+		return strings.Join(lines, "\n")
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func hclQuote(value string) string {
+	quoted := strconv.Quote(value)
+	// escape template sequences
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	quoted = strings.ReplaceAll(quoted, "%{", "%%{")
+	return quoted
+}
+
+// flattenArguments converts arguments to the flat map of HCL expressions,
+// nested block arguments use `<block>.<index>.<key>` keys and map entries use `<map>.<key>` keys
+func flattenArguments(args []planArgument, prefix string, result map[string]string) {
+	indexes := map[string]int{}
+	for _, arg := range args {
+		if block, ok := arg.Value.([]planArgument); ok {
+			index := indexes[arg.Key]
+			indexes[arg.Key]++
+			flattenArguments(block, fmt.Sprintf("%s%s.%d.", prefix, arg.Key, index), result)
+			continue
+		}
+		if tags, ok := arg.Value.(map[string]string); ok {
+			for key, value := range tags {
+				result[fmt.Sprintf("%s%s.%s", prefix, arg.Key, key)] = hclQuote(value)
+			}
+			continue
+		}
+		result[prefix+arg.Key] = hclExpression(arg.Value)
+	}
+}
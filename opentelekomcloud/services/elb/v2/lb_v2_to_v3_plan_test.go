@@ -0,0 +1,135 @@
+package v2
+
+import (
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestMigrationPlanAddResourceNames(t *testing.T) {
+	cases := []struct {
+		name     string
+		resource []string // resource type and v2 name pairs
+		expected []string
+	}{
+		{
+			name:     "sanitized",
+			resource: []string{"opentelekomcloud_lb_pool_v3", "My-Pool.1"},
+			expected: []string{"my_pool_1"},
+		},
+		{
+			name:     "leading digit",
+			resource: []string{"opentelekomcloud_lb_pool_v3", "1st"},
+			expected: []string{"lb_1st"},
+		},
+		{
+			name:     "empty name",
+			resource: []string{"opentelekomcloud_lb_listener_v3", "---"},
+			expected: []string{"listener"},
+		},
+		{
+			name: "duplicates",
+			resource: []string{
+				"opentelekomcloud_lb_pool_v3", "web",
+				"opentelekomcloud_lb_pool_v3", "web",
+				"opentelekomcloud_lb_pool_v3", "WEB",
+			},
+			expected: []string{"web", "web_2", "web_3"},
+		},
+		{
+			name: "suffixed name is taken",
+			resource: []string{
+				"opentelekomcloud_lb_pool_v3", "web",
+				"opentelekomcloud_lb_pool_v3", "web_2",
+				"opentelekomcloud_lb_pool_v3", "web",
+				"opentelekomcloud_lb_pool_v3", "web_2",
+			},
+			expected: []string{"web", "web_2", "web_3", "web_2_2"},
+		},
+		{
+			name: "different types",
+			resource: []string{
+				"opentelekomcloud_lb_pool_v3", "web",
+				"opentelekomcloud_lb_listener_v3", "web",
+			},
+			expected: []string{"web", "web"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			plan := newMigrationPlan()
+			var names []string
+			for i := 0; i < len(c.resource); i += 2 {
+				names = append(names, plan.addResource(c.resource[i], "pool", "", c.resource[i+1]).Name)
+			}
+			th.AssertDeepEquals(t, c.expected, names)
+		})
+	}
+}
+
+func TestMigrationPlanRefOrID(t *testing.T) {
+	plan := newMigrationPlan()
+	plan.addResource("opentelekomcloud_lb_loadbalancer_v3", "loadbalancer", "lb-id", "lb")
+
+	th.AssertEquals(t, planRef("opentelekomcloud_lb_loadbalancer_v3.lb.id"), plan.refOrID("lb-id"))
+	th.AssertEquals(t, "other-id", plan.refOrID("other-id"))
+}
+
+func TestMigrationPlanHCL(t *testing.T) {
+	plan := newMigrationPlan()
+	lb := plan.addResource("opentelekomcloud_lb_loadbalancer_v3", "loadbalancer", "lb-id", "lb")
+	lb.add("name", "lb")
+	lb.add("availability_zones", []string{"eu-de-01", "eu-de-02"})
+	lb.add("tags", map[string]string{"app": "web", "cost-center": "${var}"})
+
+	listener := plan.addResource("opentelekomcloud_lb_listener_v3", "listener", "listener-id", "http")
+	listener.add("loadbalancer_id", plan.refOrID("lb-id"))
+	listener.add("protocol_port", 80)
+	listener.add("http2_enable", false)
+	listener.addString("description", "")
+	listener.add("insert_headers", []planArgument{{Key: "forwarded_host", Value: true}})
+
+	expected := `# migrated from loadbalancer lb-id
+resource "opentelekomcloud_lb_loadbalancer_v3" "lb" {
+  name               = "lb"
+  availability_zones = ["eu-de-01", "eu-de-02"]
+  tags               = {
+    "app"         = "web"
+    "cost-center" = "$${var}"
+  }
+}
+
+# migrated from listener listener-id
+resource "opentelekomcloud_lb_listener_v3" "http" {
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.lb.id
+  protocol_port   = 80
+  http2_enable    = false
+
+  insert_headers {
+    forwarded_host = true
+  }
+}
+`
+	th.AssertEquals(t, expected, plan.HCL())
+}
+
+func TestFlattenArguments(t *testing.T) {
+	args := []planArgument{
+		{Key: "name", Value: "pool"},
+		{Key: "loadbalancer_id", Value: planRef("opentelekomcloud_lb_loadbalancer_v3.lb.id")},
+		{Key: "tags", Value: map[string]string{"app": "web"}},
+		{Key: "persistence", Value: []planArgument{{Key: "type", Value: "HTTP_COOKIE"}}},
+		{Key: "persistence", Value: []planArgument{{Key: "timeout", Value: 60}}},
+	}
+	result := map[string]string{}
+	flattenArguments(args, "", result)
+
+	th.AssertDeepEquals(t, map[string]string{
+		"name":                  `"pool"`,
+		"loadbalancer_id":       "opentelekomcloud_lb_loadbalancer_v3.lb.id",
+		"tags.app":              `"web"`,
+		"persistence.0.type":    `"HTTP_COOKIE"`,
+		"persistence.1.timeout": "60",
+	}, result)
+}
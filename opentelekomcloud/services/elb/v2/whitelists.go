@@ -0,0 +1,31 @@
+package v2
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/whitelists"
+)
+
+// Listing of the whitelists is not covered by gophertelekomcloud yet.
+
+type listWhitelistsOpts struct {
+	ListenerID string `q:"listener_id"`
+}
+
+func listWhitelists(client *golangsdk.ServiceClient, listenerID string) ([]whitelists.Whitelist, error) {
+	url, err := golangsdk.NewURLBuilder().
+		WithEndpoints("lbaas", "whitelists").
+		WithQueryParams(&listWhitelistsOpts{ListenerID: listenerID}).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET /v2.0/lbaas/whitelists
+	var res struct {
+		Whitelists []whitelists.Whitelist `json:"whitelists"`
+	}
+	_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Whitelists, nil
+}
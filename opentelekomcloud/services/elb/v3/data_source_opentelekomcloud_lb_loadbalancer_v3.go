@@ -40,6 +40,22 @@ func DataSourceLoadBalancerV3() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"ipv6_network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_bandwidth_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_vip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"router_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -0,0 +1,88 @@
+package v3
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceLBLogV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBLogV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"log_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"log_topic_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBLogV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	opts := ListLogTankOpts{
+		ID:             d.Get("id").(string),
+		LoadBalancerID: d.Get("loadbalancer_id").(string),
+		LogGroupID:     d.Get("log_group_id").(string),
+		LogTopicID:     d.Get("log_topic_id").(string),
+	}
+	logTanks, err := ListLogTanks(client, opts)
+	if err != nil {
+		return fmterr.Errorf("error listing LoadBalancer logs: %w", err)
+	}
+
+	if len(logTanks) == 0 {
+		return fmterr.Errorf("no LoadBalancer log found. Please change your search criteria and try again")
+	}
+	if len(logTanks) > 1 {
+		return fmterr.Errorf("multiple LoadBalancer logs matched; use additional constraints to reduce matches to a single log")
+	}
+
+	logTank := logTanks[0]
+	log.Printf("[DEBUG] Retrieved LoadBalancer log %s: %#v", logTank.ID, logTank)
+	d.SetId(logTank.ID)
+
+	mErr := multierror.Append(nil,
+		d.Set("loadbalancer_id", logTank.LoadBalancerID),
+		d.Set("log_group_id", logTank.LogGroupID),
+		d.Set("log_topic_id", logTank.LogTopicID),
+		d.Set("project_id", logTank.ProjectID),
+	)
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/members"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceLBMembersV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBMembersV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"operating_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ONLINE", "OFFLINE", "NO_MONITOR",
+				}, false),
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ip_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operating_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBMembersV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	opts := members.ListOpts{
+		Name:            d.Get("name").(string),
+		Address:         d.Get("address").(string),
+		ProtocolPort:    d.Get("protocol_port").(int),
+		OperatingStatus: d.Get("operating_status").(string),
+	}
+	pages, err := members.List(client, poolID, opts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("error listing LB members v3: %w", err)
+	}
+	memberList, err := members.ExtractMembers(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting LB members v3: %w", err)
+	}
+
+	subnetID := d.Get("subnet_id").(string)
+	var ids []string
+	var result []map[string]interface{}
+	for _, member := range memberList {
+		if subnetID != "" && member.SubnetID != subnetID {
+			continue
+		}
+		ids = append(ids, member.ID)
+		result = append(result, map[string]interface{}{
+			"id":               member.ID,
+			"name":             member.Name,
+			"address":          member.Address,
+			"protocol_port":    member.ProtocolPort,
+			"subnet_id":        member.SubnetID,
+			"weight":           member.Weight,
+			"admin_state_up":   member.AdminStateUp,
+			"ip_version":       member.IpVersion,
+			"operating_status": member.OperatingStatus,
+			"project_id":       member.ProjectID,
+		})
+	}
+	d.SetId(hashcode.Strings(append(ids, poolID)))
+
+	mErr := multierror.Append(
+		d.Set("members", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
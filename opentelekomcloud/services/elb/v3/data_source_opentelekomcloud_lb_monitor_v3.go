@@ -0,0 +1,144 @@
+package v3
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/monitors"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceLBMonitorV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBMonitorV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delay": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_retries": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_retries_down": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"http_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expected_codes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"monitor_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBMonitorV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	opts := monitors.ListOpts{
+		ID:     d.Get("id").(string),
+		Name:   d.Get("name").(string),
+		PoolID: d.Get("pool_id").(string),
+		Type:   d.Get("type").(string),
+	}
+	pages, err := monitors.List(client, opts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("error listing LB monitors v3: %w", err)
+	}
+	monitorList, err := monitors.ExtractMonitors(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting LB monitors v3: %w", err)
+	}
+
+	if len(monitorList) == 0 {
+		return common.DataSourceTooFewDiag
+	}
+	if len(monitorList) > 1 {
+		return common.DataSourceTooManyDiag
+	}
+
+	monitor := monitorList[0]
+	log.Printf("[DEBUG] Retrieved LB monitor v3 %s: %#v", monitor.ID, monitor)
+	d.SetId(monitor.ID)
+
+	mErr := multierror.Append(
+		d.Set("name", monitor.Name),
+		d.Set("type", monitor.Type),
+		d.Set("project_id", monitor.ProjectID),
+		d.Set("delay", monitor.Delay),
+		d.Set("timeout", monitor.Timeout),
+		d.Set("max_retries", monitor.MaxRetries),
+		d.Set("max_retries_down", monitor.MaxRetriesDown),
+		d.Set("http_method", monitor.HTTPMethod),
+		d.Set("url_path", monitor.URLPath),
+		d.Set("domain_name", monitor.DomainName),
+		d.Set("expected_codes", monitor.ExpectedCodes),
+		d.Set("admin_state_up", monitor.AdminStateUp),
+		d.Set("monitor_port", monitor.MonitorPort),
+	)
+	if len(monitor.Pools) != 0 {
+		mErr = multierror.Append(mErr, d.Set("pool_id", monitor.Pools[0].ID))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/rules"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceLBPoliciesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBPoliciesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"position": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"redirect_pool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"redirect_listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"redirect_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"compare_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"conditions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBPoliciesV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	opts := policies.ListOpts{
+		ListenerID:     common.StrSlice(d.Get("listener_id")),
+		Name:           common.StrSlice(d.Get("name")),
+		Action:         common.StrSlice(d.Get("action")),
+		RedirectPoolID: common.StrSlice(d.Get("redirect_pool_id")),
+	}
+	pages, err := policies.List(client, opts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("error listing LB policies v3: %w", err)
+	}
+	policyList, err := policies.ExtractPolicies(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting LB policies v3: %w", err)
+	}
+
+	var ids []string
+	result := make([]map[string]interface{}, len(policyList))
+	for i, policy := range policyList {
+		policyRules, err := flattenLBPolicyV3Rules(client, policy.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ids = append(ids, policy.ID)
+		result[i] = map[string]interface{}{
+			"id":                   policy.ID,
+			"name":                 policy.Name,
+			"description":          policy.Description,
+			"project_id":           policy.ProjectID,
+			"action":               policy.Action,
+			"listener_id":          policy.ListenerID,
+			"position":             policy.Position,
+			"priority":             policy.Priority,
+			"status":               policy.Status,
+			"redirect_pool_id":     policy.RedirectPoolID,
+			"redirect_listener_id": policy.RedirectListenerID,
+			"redirect_url":         policy.RedirectUrl,
+			"rules":                policyRules,
+		}
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(
+		d.Set("policies", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func flattenLBPolicyV3Rules(client *golangsdk.ServiceClient, policyID string) ([]map[string]interface{}, error) {
+	pages, err := rules.List(client, policyID, nil).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing rules of LB policy v3 %s: %w", policyID, err)
+	}
+	ruleList, err := rules.ExtractRules(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting rules of LB policy v3 %s: %w", policyID, err)
+	}
+
+	result := make([]map[string]interface{}, len(ruleList))
+	for i, rule := range ruleList {
+		conditions := make([]map[string]interface{}, len(rule.Conditions))
+		for j, condition := range rule.Conditions {
+			conditions[j] = map[string]interface{}{
+				"key":   condition.Key,
+				"value": condition.Value,
+			}
+		}
+		result[i] = map[string]interface{}{
+			"id":           rule.ID,
+			"type":         rule.Type,
+			"compare_type": rule.CompareType,
+			"value":        rule.Value,
+			"conditions":   conditions,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package v3
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceLBPoolV3() *schema.Resource {
+	poolSchema := lbPoolV3Schema()
+	// filters
+	for _, key := range []string{"id", "name", "description", "loadbalancer_id", "listener_id", "healthmonitor_id", "protocol", "lb_algorithm"} {
+		poolSchema[key].Optional = true
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceLBPoolV3Read,
+
+		Schema: poolSchema,
+	}
+}
+
+func dataSourceLBPoolV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	poolList, err := listLBPoolsV3(client, d)
+	if err != nil {
+		return fmterr.Errorf("error listing LB pools v3: %w", err)
+	}
+
+	if len(poolList) == 0 {
+		return common.DataSourceTooFewDiag
+	}
+	if len(poolList) > 1 {
+		return common.DataSourceTooManyDiag
+	}
+
+	pool := poolList[0]
+	log.Printf("[DEBUG] Retrieved LB pool v3 %s: %#v", pool.ID, pool)
+	d.SetId(pool.ID)
+
+	mErr := &multierror.Error{}
+	for key, value := range flattenLBPoolV3(pool) {
+		if key == "id" {
+			continue
+		}
+		mErr = multierror.Append(mErr, d.Set(key, value))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/pools"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceLBPoolsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBPoolsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"healthmonitor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lb_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: lbPoolV3Schema(),
+				},
+			},
+		},
+	}
+}
+
+// lbPoolV3Schema returns computed attributes of the pool shared by the pool data sources
+func lbPoolV3Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"project_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"lb_algorithm": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"protocol": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"loadbalancer_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"listener_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"healthmonitor_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"members": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"session_persistence": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cookie_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"persistence_timeout": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"ip_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"member_deletion_protection": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"vpc_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func listLBPoolsV3(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]pools.Pool, error) {
+	opts := pools.ListOpts{
+		Name:            common.StrSlice(d.Get("name")),
+		Description:     common.StrSlice(d.Get("description")),
+		LoadbalancerID:  common.StrSlice(d.Get("loadbalancer_id")),
+		HealthMonitorID: common.StrSlice(d.Get("healthmonitor_id")),
+		Protocol:        common.StrSlice(d.Get("protocol")),
+		LBMethod:        common.StrSlice(d.Get("lb_algorithm")),
+	}
+	if v, ok := d.GetOk("id"); ok {
+		opts.ID = []string{v.(string)}
+	}
+	pages, err := pools.List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	poolList, err := pools.ExtractPools(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	// listener_id is not supported by the API filter
+	listenerID := d.Get("listener_id").(string)
+	if listenerID == "" {
+		return poolList, nil
+	}
+	var filtered []pools.Pool
+	for _, pool := range poolList {
+		for _, listener := range pool.Listeners {
+			if listener.ID == listenerID {
+				filtered = append(filtered, pool)
+				break
+			}
+		}
+	}
+	return filtered, nil
+}
+
+func flattenLBPoolV3(pool pools.Pool) map[string]interface{} {
+	var members []string
+	for _, member := range pool.Members {
+		members = append(members, member.ID)
+	}
+	result := map[string]interface{}{
+		"id":                         pool.ID,
+		"name":                       pool.Name,
+		"description":                pool.Description,
+		"project_id":                 pool.ProjectID,
+		"lb_algorithm":               pool.LBMethod,
+		"protocol":                   pool.Protocol,
+		"healthmonitor_id":           pool.MonitorID,
+		"members":                    members,
+		"session_persistence":        expandPersistence(pool.Persistence),
+		"ip_version":                 pool.IpVersion,
+		"member_deletion_protection": pool.DeletionProtectionEnable,
+		"vpc_id":                     pool.VpcId,
+		"type":                       pool.Type,
+	}
+	if len(pool.Loadbalancers) != 0 {
+		result["loadbalancer_id"] = pool.Loadbalancers[0].ID
+	}
+	if len(pool.Listeners) != 0 {
+		result["listener_id"] = pool.Listeners[0].ID
+	}
+	return result
+}
+
+func dataSourceLBPoolsV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	poolList, err := listLBPoolsV3(client, d)
+	if err != nil {
+		return fmterr.Errorf("error listing LB pools v3: %w", err)
+	}
+
+	var ids []string
+	result := make([]map[string]interface{}, len(poolList))
+	for i, pool := range poolList {
+		ids = append(ids, pool.ID)
+		result[i] = flattenLBPoolV3(pool)
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(
+		d.Set("pools", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package v3
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// The ELB v3 logtank API is not covered by gophertelekomcloud yet.
+
+type LogTankOpts struct {
+	// ID of the load balancer. Can't be updated.
+	LoadBalancerID string `json:"loadbalancer_id,omitempty"`
+	// ID of the LTS log group.
+	LogGroupID string `json:"log_group_id,omitempty"`
+	// ID of the LTS log stream (topic).
+	LogTopicID string `json:"log_topic_id,omitempty"`
+}
+
+type LogTank struct {
+	ID             string `json:"id"`
+	ProjectID      string `json:"project_id"`
+	LoadBalancerID string `json:"loadbalancer_id"`
+	LogGroupID     string `json:"log_group_id"`
+	LogTopicID     string `json:"log_topic_id"`
+}
+
+func CreateLogTank(client *golangsdk.ServiceClient, opts LogTankOpts) (*LogTank, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "logtank")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v3/{project_id}/elb/logtanks
+	var res struct {
+		LogTank LogTank `json:"logtank"`
+	}
+	_, err = client.Post(client.ServiceURL("logtanks"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return &res.LogTank, err
+}
+
+func GetLogTank(client *golangsdk.ServiceClient, id string) (*LogTank, error) {
+	// GET /v3/{project_id}/elb/logtanks/{logtank_id}
+	var res struct {
+		LogTank LogTank `json:"logtank"`
+	}
+	_, err := client.Get(client.ServiceURL("logtanks", id), &res, nil)
+	return &res.LogTank, err
+}
+
+type ListLogTankOpts struct {
+	ID             string `q:"id"`
+	LoadBalancerID string `q:"loadbalancer_id"`
+	LogGroupID     string `q:"log_group_id"`
+	LogTopicID     string `q:"log_topic_id"`
+	Limit          int    `q:"limit"`
+	Marker         string `q:"marker"`
+}
+
+func ListLogTanks(client *golangsdk.ServiceClient, opts ListLogTankOpts) ([]LogTank, error) {
+	var tanks []LogTank
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("logtanks").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v3/{project_id}/elb/logtanks
+		var res struct {
+			LogTanks []LogTank `json:"logtanks"`
+			PageInfo struct {
+				NextMarker string `json:"next_marker"`
+			} `json:"page_info"`
+		}
+		_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		tanks = append(tanks, res.LogTanks...)
+		if res.PageInfo.NextMarker == "" || len(res.LogTanks) == 0 {
+			return tanks, nil
+		}
+		opts.Marker = res.PageInfo.NextMarker
+	}
+}
+
+func UpdateLogTank(client *golangsdk.ServiceClient, id string, opts LogTankOpts) (*LogTank, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "logtank")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT /v3/{project_id}/elb/logtanks/{logtank_id}
+	var res struct {
+		LogTank LogTank `json:"logtank"`
+	}
+	_, err = client.Put(client.ServiceURL("logtanks", id), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return &res.LogTank, err
+}
+
+func DeleteLogTank(client *golangsdk.ServiceClient, id string) error {
+	// DELETE /v3/{project_id}/elb/logtanks/{logtank_id}
+	_, err := client.Delete(client.ServiceURL("logtanks", id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
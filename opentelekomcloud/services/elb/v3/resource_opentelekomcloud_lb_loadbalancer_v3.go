@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
@@ -29,6 +30,11 @@ func ResourceLoadBalancerV3() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.ForceNewIfChange("ipv6_bandwidth_id", func(_ context.Context, old, new, _ interface{}) bool {
+			// IPv6 bandwidth can be changed, but can't be unbound
+			return old.(string) != "" && new.(string) == ""
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -163,6 +169,15 @@ func ResourceLoadBalancerV3() *schema.Resource {
 					},
 				},
 			},
+			"ipv6_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ipv6_bandwidth_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"ipv6_network_id"},
+			},
 			"tags": {
 				Type:         schema.TypeMap,
 				Optional:     true,
@@ -172,6 +187,14 @@ func ResourceLoadBalancerV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ipv6_vip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -236,6 +259,10 @@ func resourceLoadBalancerV3Create(ctx context.Context, d *schema.ResourceData, m
 		ElbSubnetIDs:             common.ExpandToStringSlice(d.Get("network_ids").(*schema.Set).List()),
 		IpTargetEnable:           &ipTargetEnable,
 		DeletionProtectionEnable: &deletionProtection,
+		IpV6VipSubnetID:          d.Get("ipv6_network_id").(string),
+	}
+	if id, ok := d.GetOk("ipv6_bandwidth_id"); ok {
+		createOpts.IPV6Bandwidth = &loadbalancers.BandwidthRef{ID: id.(string)}
 	}
 
 	// currently API supports only a single EIP
@@ -314,6 +341,13 @@ func resourceLoadBalancerV3Update(ctx context.Context, d *schema.ResourceData, m
 		subnetID := d.Get("subnet_id").(string)
 		updateOpts.VipSubnetCidrID = &subnetID
 	}
+	if d.HasChange("ipv6_network_id") {
+		ipv6SubnetID := d.Get("ipv6_network_id").(string)
+		updateOpts.IpV6VipSubnetID = &ipv6SubnetID
+	}
+	if d.HasChange("ipv6_bandwidth_id") {
+		updateOpts.IpV6Bandwidth = &loadbalancers.BandwidthRef{ID: d.Get("ipv6_bandwidth_id").(string)}
+	}
 	if d.HasChange("ip_target_enable") {
 		ipTargetEnable := d.Get("ip_target_enable").(bool)
 		updateOpts.IpTargetEnable = &ipTargetEnable
@@ -394,6 +428,10 @@ func setLoadBalancerFields(d *schema.ResourceData, meta interface{}, lb *loadbal
 		d.Set("description", lb.Description),
 		d.Set("vip_address", lb.VipAddress),
 		d.Set("vip_port_id", lb.VipPortID),
+		d.Set("ipv6_network_id", lb.IpV6VipSubnetID),
+		d.Set("ipv6_bandwidth_id", lb.IpV6Bandwidth.ID),
+		d.Set("ipv6_vip_address", lb.IpV6VipAddress),
+		d.Set("ipv6_vip_port_id", lb.IpV6VipPortID),
 		d.Set("admin_state_up", lb.AdminStateUp),
 		d.Set("router_id", lb.VpcID),
 		d.Set("subnet_id", lb.VipSubnetCidrID),
@@ -0,0 +1,143 @@
+package v3
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceLBLogV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLBLogV3Create,
+		ReadContext:   resourceLBLogV3Read,
+		UpdateContext: resourceLBLogV3Update,
+		DeleteContext: resourceLBLogV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"log_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"log_topic_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLBLogV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.ElbV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	createOpts := LogTankOpts{
+		LoadBalancerID: d.Get("loadbalancer_id").(string),
+		LogGroupID:     d.Get("log_group_id").(string),
+		LogTopicID:     d.Get("log_topic_id").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	logTank, err := CreateLogTank(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating LoadBalancer log: %w", err)
+	}
+
+	d.SetId(logTank.ID)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClient)
+	return resourceLBLogV3Read(clientCtx, d, meta)
+}
+
+func resourceLBLogV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.ElbV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	logTank, err := GetLogTank(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "logV3")
+	}
+	log.Printf("[DEBUG] Retrieved LoadBalancer log %s: %#v", d.Id(), logTank)
+
+	mErr := multierror.Append(nil,
+		d.Set("loadbalancer_id", logTank.LoadBalancerID),
+		d.Set("log_group_id", logTank.LogGroupID),
+		d.Set("log_topic_id", logTank.LogTopicID),
+		d.Set("project_id", logTank.ProjectID),
+	)
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceLBLogV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.ElbV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	updateOpts := LogTankOpts{
+		LogGroupID: d.Get("log_group_id").(string),
+		LogTopicID: d.Get("log_topic_id").(string),
+	}
+
+	log.Printf("[DEBUG] Updating LoadBalancer log %s with options: %#v", d.Id(), updateOpts)
+	if _, err := UpdateLogTank(client, d.Id(), updateOpts); err != nil {
+		return fmterr.Errorf("error updating LoadBalancer log: %w", err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClient)
+	return resourceLBLogV3Read(clientCtx, d, meta)
+}
+
+func resourceLBLogV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.ElbV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	log.Printf("[DEBUG] Deleting LoadBalancer log: %s", d.Id())
+	if err := DeleteLogTank(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting LoadBalancer log")
+	}
+
+	return nil
+}
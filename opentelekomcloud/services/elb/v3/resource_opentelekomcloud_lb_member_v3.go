@@ -33,9 +33,11 @@ func ResourceLBMemberV3() *schema.Resource {
 				ForceNew: true,
 			},
 			"address": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsIPAddress,
+				DiffSuppressFunc: common.SuppressEquivalentIPAddresses,
 			},
 			"name": {
 				Type:         schema.TypeString,
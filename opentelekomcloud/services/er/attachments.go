@@ -0,0 +1,221 @@
+package er
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+// Attachments other than VPC ones are not covered by gophertelekomcloud yet.
+
+type Attachment struct {
+	ID                string             `json:"id"`
+	Name              string             `json:"name"`
+	Description       string             `json:"description"`
+	State             string             `json:"state"`
+	ProjectID         string             `json:"project_id"`
+	ResourceID        string             `json:"resource_id"`
+	ResourceType      string             `json:"resource_type"`
+	ResourceProjectID string             `json:"resource_project_id"`
+	RouteTableID      string             `json:"route_table_id"`
+	Associated        bool               `json:"associated"`
+	Tags              []tags.ResourceTag `json:"tags"`
+	CreatedAt         string             `json:"created_at"`
+	UpdatedAt         string             `json:"updated_at"`
+}
+
+type ListAttachmentsOpts struct {
+	InstanceID   string   `json:"-"`
+	ID           []string `q:"id"`
+	State        []string `q:"state"`
+	ResourceType []string `q:"resource_type"`
+	ResourceID   []string `q:"resource_id"`
+	Limit        int      `q:"limit"`
+	Marker       string   `q:"marker"`
+}
+
+type VpnAttachmentOpts struct {
+	VpnConnectionID string             `json:"vpn_connection_id,omitempty"`
+	Name            string             `json:"name,omitempty"`
+	Description     *string            `json:"description,omitempty"`
+	Tags            []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+type VpnAttachment struct {
+	Attachment
+	VpnConnectionID string `json:"vpn_connection_id"`
+}
+
+type DcAttachmentOpts struct {
+	VirtualGatewayID string             `json:"vgw_id,omitempty"`
+	Name             string             `json:"name,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	Tags             []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+type DcAttachment struct {
+	Attachment
+	VirtualGatewayID string `json:"vgw_id"`
+}
+
+type attachmentsPageInfo struct {
+	NextMarker string `json:"next_marker"`
+}
+
+func GetAttachment(client *golangsdk.ServiceClient, instanceID, id string) (*Attachment, error) {
+	// GET /v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}
+	var res struct {
+		Attachment Attachment `json:"attachment"`
+	}
+	_, err := client.Get(client.ServiceURL("enterprise-router", instanceID, "attachments", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Attachment, nil
+}
+
+func ListAttachments(client *golangsdk.ServiceClient, opts ListAttachmentsOpts) ([]Attachment, error) {
+	var attachments []Attachment
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("enterprise-router", opts.InstanceID, "attachments").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v3/{project_id}/enterprise-router/{er_id}/attachments
+		var res struct {
+			Attachments []Attachment        `json:"attachments"`
+			PageInfo    attachmentsPageInfo `json:"page_info"`
+		}
+		_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, res.Attachments...)
+		if res.PageInfo.NextMarker == "" || len(res.Attachments) == 0 {
+			return attachments, nil
+		}
+		opts.Marker = res.PageInfo.NextMarker
+	}
+}
+
+func AcceptAttachment(client *golangsdk.ServiceClient, instanceID, id string) error {
+	// POST /v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}/accept
+	_, err := client.Post(client.ServiceURL("enterprise-router", instanceID, "attachments", id, "accept"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func RejectAttachment(client *golangsdk.ServiceClient, instanceID, id string) error {
+	// POST /v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}/reject
+	_, err := client.Post(client.ServiceURL("enterprise-router", instanceID, "attachments", id, "reject"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func CreateVpnAttachment(client *golangsdk.ServiceClient, instanceID string, opts VpnAttachmentOpts) (*VpnAttachment, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "vpn_attachment")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v3/{project_id}/enterprise-router/{er_id}/vpn-attachments
+	var res struct {
+		VpnAttachment VpnAttachment `json:"vpn_attachment"`
+	}
+	_, err = client.Post(client.ServiceURL("enterprise-router", instanceID, "vpn-attachments"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.VpnAttachment, nil
+}
+
+func GetVpnAttachment(client *golangsdk.ServiceClient, instanceID, id string) (*VpnAttachment, error) {
+	// GET /v3/{project_id}/enterprise-router/{er_id}/vpn-attachments/{attachment_id}
+	var res struct {
+		VpnAttachment VpnAttachment `json:"vpn_attachment"`
+	}
+	_, err := client.Get(client.ServiceURL("enterprise-router", instanceID, "vpn-attachments", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.VpnAttachment, nil
+}
+
+func UpdateVpnAttachment(client *golangsdk.ServiceClient, instanceID, id string, opts VpnAttachmentOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "vpn_attachment")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v3/{project_id}/enterprise-router/{er_id}/vpn-attachments/{attachment_id}
+	_, err = client.Put(client.ServiceURL("enterprise-router", instanceID, "vpn-attachments", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeleteVpnAttachment(client *golangsdk.ServiceClient, instanceID, id string) error {
+	// DELETE /v3/{project_id}/enterprise-router/{er_id}/vpn-attachments/{attachment_id}
+	_, err := client.Delete(client.ServiceURL("enterprise-router", instanceID, "vpn-attachments", id), &golangsdk.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+	return err
+}
+
+func CreateDcAttachment(client *golangsdk.ServiceClient, instanceID string, opts DcAttachmentOpts) (*DcAttachment, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "vgw_attachment")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v3/{project_id}/enterprise-router/{er_id}/vgw-attachments
+	var res struct {
+		DcAttachment DcAttachment `json:"vgw_attachment"`
+	}
+	_, err = client.Post(client.ServiceURL("enterprise-router", instanceID, "vgw-attachments"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.DcAttachment, nil
+}
+
+func GetDcAttachment(client *golangsdk.ServiceClient, instanceID, id string) (*DcAttachment, error) {
+	// GET /v3/{project_id}/enterprise-router/{er_id}/vgw-attachments/{attachment_id}
+	var res struct {
+		DcAttachment DcAttachment `json:"vgw_attachment"`
+	}
+	_, err := client.Get(client.ServiceURL("enterprise-router", instanceID, "vgw-attachments", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.DcAttachment, nil
+}
+
+func UpdateDcAttachment(client *golangsdk.ServiceClient, instanceID, id string, opts DcAttachmentOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "vgw_attachment")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v3/{project_id}/enterprise-router/{er_id}/vgw-attachments/{attachment_id}
+	_, err = client.Put(client.ServiceURL("enterprise-router", instanceID, "vgw-attachments", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeleteDcAttachment(client *golangsdk.ServiceClient, instanceID, id string) error {
+	// DELETE /v3/{project_id}/enterprise-router/{er_id}/vgw-attachments/{attachment_id}
+	_, err := client.Delete(client.ServiceURL("enterprise-router", instanceID, "vgw-attachments", id), &golangsdk.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+	return err
+}
@@ -1,6 +1,39 @@
 package er
 
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
 const (
 	errCreationV3Client = "error creating OpenTelekomCloud EnterpriseRouter v3 client: %w"
 	erClientV3          = "er-v3-client"
 )
+
+func attachmentStatusRefreshFunc(client *golangsdk.ServiceClient, instanceId, attachmentId string, targets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := GetAttachment(client, instanceId, attachmentId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok && len(targets) < 1 {
+				return "", "COMPLETED", nil
+			}
+
+			return nil, "", err
+		}
+		log.Printf("[DEBUG] The details of the ER attachment (%s) is: %#v", attachmentId, resp)
+
+		if common.StrSliceContains([]string{"failed"}, resp.State) {
+			return resp, "", fmt.Errorf("unexpected status '%s'", resp.State)
+		}
+		if common.StrSliceContains(targets, resp.State) {
+			return resp, "COMPLETED", nil
+		}
+
+		return resp, "PENDING", nil
+	}
+}
@@ -0,0 +1,184 @@
+package er
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceErAttachmentsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceErAttachmentsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"attachment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vpc", "vpn", "vgw", "peering",
+				}, false),
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": common.TagsSchema(),
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"associated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceErAttachmentsV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	opts := ListAttachmentsOpts{
+		InstanceID: d.Get("instance_id").(string),
+	}
+	if id := d.Get("attachment_id").(string); id != "" {
+		opts.ID = []string{id}
+	}
+	if status := d.Get("status").(string); status != "" {
+		opts.State = []string{status}
+	}
+	if attachmentType := d.Get("type").(string); attachmentType != "" {
+		opts.ResourceType = []string{attachmentType}
+	}
+	if resourceId := d.Get("resource_id").(string); resourceId != "" {
+		opts.ResourceID = []string{resourceId}
+	}
+
+	attachments, err := ListAttachments(client, opts)
+	if err != nil {
+		return diag.Errorf("error querying ER attachments: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	tagFilter := d.Get("tags").(map[string]interface{})
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, attachment := range attachments {
+		if name != "" && attachment.Name != name {
+			continue
+		}
+		tagsMap := common.TagsToMap(attachment.Tags)
+		if !hasTags(tagsMap, tagFilter) {
+			continue
+		}
+		ids = append(ids, attachment.ID)
+		result = append(result, map[string]interface{}{
+			"id":                  attachment.ID,
+			"name":                attachment.Name,
+			"description":         attachment.Description,
+			"status":              attachment.State,
+			"type":                attachment.ResourceType,
+			"resource_id":         attachment.ResourceID,
+			"resource_project_id": attachment.ResourceProjectID,
+			"route_table_id":      attachment.RouteTableID,
+			"associated":          attachment.Associated,
+			"tags":                tagsMap,
+			"created_at":          attachment.CreatedAt,
+			"updated_at":          attachment.UpdatedAt,
+		})
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("attachments", result),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving ER attachments fields: %s", mErr)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package er
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/er/v3/instance"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceErInstancesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceErInstancesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": common.TagsSchema(),
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enable_default_propagation": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_default_association": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"default_propagation_route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_association_route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auto_accept_shared_attachments": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceErInstancesV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	opts := instance.ListOpts{}
+	if id := d.Get("instance_id").(string); id != "" {
+		opts.ID = []string{id}
+	}
+	if status := d.Get("status").(string); status != "" {
+		opts.State = []string{status}
+	}
+
+	var instances []instance.RouterInstance
+	for {
+		resp, err := instance.List(client, opts)
+		if err != nil {
+			return diag.Errorf("error querying ER instances: %s", err)
+		}
+		instances = append(instances, resp.Instances...)
+		if resp.PageInfo.NextMarker == "" || len(resp.Instances) == 0 {
+			break
+		}
+		opts.Marker = resp.PageInfo.NextMarker
+	}
+
+	name := d.Get("name").(string)
+	tagFilter := d.Get("tags").(map[string]interface{})
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, inst := range instances {
+		if name != "" && inst.Name != name {
+			continue
+		}
+		tagsMap := common.TagsToMap(inst.Tags)
+		if !hasTags(tagsMap, tagFilter) {
+			continue
+		}
+		ids = append(ids, inst.ID)
+		result = append(result, map[string]interface{}{
+			"id":                                 inst.ID,
+			"name":                               inst.Name,
+			"description":                        inst.Description,
+			"asn":                                int(inst.Asn),
+			"status":                             inst.State,
+			"availability_zones":                 inst.AvailabilityZoneIDs,
+			"enable_default_propagation":         inst.EnableDefaultPropagation,
+			"enable_default_association":         inst.EnableDefaultAssociation,
+			"default_propagation_route_table_id": inst.DefaultPropagationRouteTableID,
+			"default_association_route_table_id": inst.DefaultAssociationRouteTableID,
+			"auto_accept_shared_attachments":     inst.AutoAcceptSharedAttachments,
+			"tags":                               tagsMap,
+			"created_at":                         inst.CreatedAt,
+			"updated_at":                         inst.UpdatedAt,
+		})
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instances", result),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving ER instances fields: %s", mErr)
+	}
+	return nil
+}
+
+// hasTags checks that all the tags of the filter are present in tagsMap
+func hasTags(tagsMap map[string]string, filter map[string]interface{}) bool {
+	for k, v := range filter {
+		if value, ok := tagsMap[k]; !ok || value != v.(string) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,154 @@
+package er
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/er/v3/route_table"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceErRouteTablesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceErRouteTablesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": common.TagsSchema(),
+			"route_tables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_default_association": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_default_propagation": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceErRouteTablesV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	opts := route_table.ListOpts{
+		RouterId: d.Get("instance_id").(string),
+	}
+
+	var routeTables []route_table.RouteTable
+	for {
+		resp, err := route_table.List(client, opts)
+		if err != nil {
+			return diag.Errorf("error querying ER route tables: %s", err)
+		}
+		routeTables = append(routeTables, resp.RouteTables...)
+		if resp.PageInfo == nil || resp.PageInfo.NextMarker == "" || len(resp.RouteTables) == 0 {
+			break
+		}
+		opts.Marker = resp.PageInfo.NextMarker
+	}
+
+	routeTableId := d.Get("route_table_id").(string)
+	name := d.Get("name").(string)
+	tagFilter := d.Get("tags").(map[string]interface{})
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, rt := range routeTables {
+		if routeTableId != "" && rt.ID != routeTableId {
+			continue
+		}
+		if name != "" && rt.Name != name {
+			continue
+		}
+		tagsMap := common.TagsToMap(rt.Tags)
+		if !hasTags(tagsMap, tagFilter) {
+			continue
+		}
+		ids = append(ids, rt.ID)
+		result = append(result, map[string]interface{}{
+			"id":                     rt.ID,
+			"name":                   rt.Name,
+			"description":            rt.Description,
+			"is_default_association": rt.IsDefaultAssociation,
+			"is_default_propagation": rt.IsDefaultPropagation,
+			"status":                 rt.State,
+			"tags":                   tagsMap,
+			"created_at":             rt.CreatedAt,
+			"updated_at":             rt.UpdatedAt,
+		})
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("route_tables", result),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving ER route tables fields: %s", mErr)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package er
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceErAttachmentAcceptorV3 accepts or rejects the attachment created
+// by another account in the shared ER instance.
+func ResourceErAttachmentAcceptorV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAttachmentAcceptorV3Create,
+		ReadContext:   resourceAttachmentAcceptorV3Read,
+		DeleteContext: resourceAttachmentAcceptorV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"attachment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "accept",
+				ValidateFunc: validation.StringInSlice([]string{
+					"accept", "reject",
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAttachmentAcceptorV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	attachmentId := d.Get("attachment_id").(string)
+
+	target := "available"
+	if d.Get("action").(string) == "accept" {
+		err = AcceptAttachment(client, instanceId, attachmentId)
+	} else {
+		target = "rejected"
+		err = RejectAttachment(client, instanceId, attachmentId)
+	}
+	if err != nil {
+		return diag.Errorf("error processing ER attachment (%s): %s", attachmentId, err)
+	}
+	d.SetId(attachmentId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      attachmentStatusRefreshFunc(client, instanceId, attachmentId, []string{target}),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, erClientV3)
+	return resourceAttachmentAcceptorV3Read(clientCtx, d, meta)
+}
+
+func resourceAttachmentAcceptorV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(region)
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	resp, err := GetAttachment(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER attachment")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", resp.Name),
+		d.Set("resource_type", resp.ResourceType),
+		d.Set("resource_id", resp.ResourceID),
+		d.Set("resource_project_id", resp.ResourceProjectID),
+		d.Set("status", resp.State),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving ER attachment (%s) fields: %s", d.Id(), mErr)
+	}
+	return nil
+}
+
+func resourceAttachmentAcceptorV3Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] ER attachment (%s) is owned by another account, removing from the state only", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,256 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceErDcAttachmentV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcAttachmentV3Create,
+		UpdateContext: resourceDcAttachmentV3Update,
+		ReadContext:   resourceDcAttachmentV3Read,
+		DeleteContext: resourceDcAttachmentV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDcAttachmentV3ImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"virtual_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 255),
+					validation.StringMatch(regexp.MustCompile(`^[^<>]*$`),
+						"The angle brackets (< and >) are not allowed."),
+				),
+			},
+			"tags": common.TagsSchema(),
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDcAttachmentV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+
+	opts := DcAttachmentOpts{
+		VirtualGatewayID: d.Get("virtual_gateway_id").(string),
+		Name:             d.Get("name").(string),
+		Description:      pointerto.String(d.Get("description").(string)),
+		Tags:             common.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	resp, err := CreateDcAttachment(client, instanceId, opts)
+	if err != nil {
+		return diag.Errorf("error creating DC attachment: %s", err)
+	}
+	d.SetId(resp.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      attachmentStatusRefreshFunc(client, instanceId, d.Id(), []string{"available"}),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, erClientV3)
+	return resourceDcAttachmentV3Read(clientCtx, d, meta)
+}
+
+func resourceDcAttachmentV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		config       = meta.(*cfg.Config)
+		instanceId   = d.Get("instance_id").(string)
+		attachmentId = d.Id()
+		region       = config.GetRegion(d)
+	)
+
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(region)
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	resp, err := GetDcAttachment(client, instanceId, attachmentId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER DC attachment")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("virtual_gateway_id", resp.VirtualGatewayID),
+		d.Set("name", resp.Name),
+		d.Set("description", resp.Description),
+		d.Set("route_table_id", resp.RouteTableID),
+		d.Set("status", resp.State),
+		d.Set("created_at", resp.CreatedAt),
+		d.Set("updated_at", resp.UpdatedAt),
+		d.Set("tags", common.TagsToMap(resp.Tags)),
+	)
+
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving DC attachment (%s) fields: %s", d.Id(), mErr)
+	}
+	return nil
+}
+
+func resourceDcAttachmentV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		config       = meta.(*cfg.Config)
+		instanceId   = d.Get("instance_id").(string)
+		attachmentId = d.Id()
+	)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	if d.HasChanges("name", "description") {
+		opts := DcAttachmentOpts{
+			Name:        d.Get("name").(string),
+			Description: pointerto.String(d.Get("description").(string)),
+		}
+
+		err = UpdateDcAttachment(client, instanceId, attachmentId, opts)
+		if err != nil {
+			return fmterr.Errorf("error updating DC attachment (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"PENDING"},
+			Target:       []string{"COMPLETED"},
+			Refresh:      attachmentStatusRefreshFunc(client, instanceId, attachmentId, []string{"available"}),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        5 * time.Second,
+			PollInterval: 10 * time.Second,
+		}
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		err = UpdateErTags(client, d, "vgw-attachment", d.Id())
+		if err != nil {
+			return diag.Errorf("error updating OpenTelekomCloud EnterpriseRouter v3 DC attachment tags: %s", err)
+		}
+	}
+
+	return resourceDcAttachmentV3Read(ctx, d, meta)
+}
+
+func resourceDcAttachmentV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	attachmentId := d.Id()
+
+	err = DeleteDcAttachment(client, instanceId, attachmentId)
+	if err != nil {
+		return diag.Errorf("error deleting DC attachment (%s) form the ER instance: %s", attachmentId, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      attachmentStatusRefreshFunc(client, instanceId, attachmentId, nil),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceDcAttachmentV3ImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<instance_id>/<attachment_id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
@@ -0,0 +1,238 @@
+package er
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const errCreationRamClient = "error creating OpenTelekomCloud RAM v1 client: %w"
+
+// ResourceErShareV3 shares the ER instance with other accounts, so they can
+// attach their VPCs to it.
+func ResourceErShareV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceErShareV3Create,
+		ReadContext:   resourceErShareV3Read,
+		UpdateContext: resourceErShareV3Update,
+		DeleteContext: resourceErShareV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"principals": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owning_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceErShareV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	erClient, err := config.ErV3Client(region)
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+	client, err := config.RamV1Client()
+	if err != nil {
+		return fmterr.Errorf(errCreationRamClient, err)
+	}
+
+	opts := CreateResourceShareOpts{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		ResourceURNs: []string{instanceURN(region, erClient.ProjectID, d.Get("instance_id").(string))},
+		Principals:   common.ExpandToStringSlice(d.Get("principals").(*schema.Set).List()),
+	}
+
+	share, err := CreateResourceShare(client, opts)
+	if err != nil {
+		return diag.Errorf("error creating ER instance share: %s", err)
+	}
+	d.SetId(share.ID)
+
+	return resourceErShareV3Read(ctx, d, meta)
+}
+
+func resourceErShareV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RamV1Client()
+	if err != nil {
+		return fmterr.Errorf(errCreationRamClient, err)
+	}
+
+	share, err := GetResourceShare(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER instance share")
+	}
+
+	resources, err := ListResourceShareAssociations(client, d.Id(), "resource")
+	if err != nil {
+		return diag.Errorf("error retrieving resources of the ER instance share (%s): %s", d.Id(), err)
+	}
+	principals, err := ListResourceShareAssociations(client, d.Id(), "principal")
+	if err != nil {
+		return diag.Errorf("error retrieving principals of the ER instance share (%s): %s", d.Id(), err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", share.Name),
+		d.Set("description", share.Description),
+		d.Set("owning_account_id", share.OwningAccountID),
+		d.Set("status", share.Status),
+		d.Set("created_at", share.CreatedAt),
+		d.Set("updated_at", share.UpdatedAt),
+		d.Set("principals", activeAssociations(principals)),
+	)
+	if urns := activeAssociations(resources); len(urns) != 0 {
+		parts := strings.Split(urns[0], ":")
+		mErr = multierror.Append(mErr,
+			d.Set("resource_urn", urns[0]),
+			d.Set("instance_id", parts[len(parts)-1]),
+		)
+	}
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving ER instance share (%s) fields: %s", d.Id(), mErr)
+	}
+	return nil
+}
+
+func resourceErShareV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RamV1Client()
+	if err != nil {
+		return fmterr.Errorf(errCreationRamClient, err)
+	}
+
+	if d.HasChanges("name", "description") {
+		opts := UpdateResourceShareOpts{
+			Name:        d.Get("name").(string),
+			Description: pointerto.String(d.Get("description").(string)),
+		}
+		if err := UpdateResourceShare(client, d.Id(), opts); err != nil {
+			return diag.Errorf("error updating ER instance share (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("principals") {
+		oldRaw, newRaw := d.GetChange("principals")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+		if removed := common.ExpandToStringSlice(oldSet.Difference(newSet).List()); len(removed) != 0 {
+			if err := DisassociateResourceSharePrincipals(client, d.Id(), removed); err != nil {
+				return diag.Errorf("error removing principals from ER instance share (%s): %s", d.Id(), err)
+			}
+		}
+		if added := common.ExpandToStringSlice(newSet.Difference(oldSet).List()); len(added) != 0 {
+			if err := AssociateResourceSharePrincipals(client, d.Id(), added); err != nil {
+				return diag.Errorf("error adding principals to ER instance share (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceErShareV3Read(ctx, d, meta)
+}
+
+func resourceErShareV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RamV1Client()
+	if err != nil {
+		return fmterr.Errorf(errCreationRamClient, err)
+	}
+
+	if err := DeleteResourceShare(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "ER instance share")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			share, err := GetResourceShare(client, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "", err
+			}
+			return share, "PENDING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        2 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func activeAssociations(associations []ResourceShareAssociation) []string {
+	var entities []string
+	for _, association := range associations {
+		if association.Status == "disassociated" || association.Status == "disassociating" {
+			continue
+		}
+		entities = append(entities, association.AssociatedEntity)
+	}
+	return entities
+}
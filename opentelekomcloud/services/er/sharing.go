@@ -0,0 +1,147 @@
+package er
+
+import (
+	"fmt"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Resource sharing API is not covered by gophertelekomcloud yet.
+
+type CreateResourceShareOpts struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	ResourceURNs []string `json:"resource_urns"`
+	Principals   []string `json:"principals,omitempty"`
+}
+
+type UpdateResourceShareOpts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type resourceShareAssociationOpts struct {
+	Principals []string `json:"principals"`
+}
+
+type ResourceShare struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	OwningAccountID string `json:"owning_account_id"`
+	Status          string `json:"status"`
+	AllowExternal   bool   `json:"allow_external_principals"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+type ResourceShareAssociation struct {
+	AssociatedEntity string `json:"associated_entity"`
+	AssociationType  string `json:"association_type"`
+	Status           string `json:"status"`
+}
+
+// instanceURN builds URN of the ER instance used by the resource sharing
+func instanceURN(region, projectID, instanceID string) string {
+	return fmt.Sprintf("er:%s:%s:instances:%s", region, projectID, instanceID)
+}
+
+func CreateResourceShare(client *golangsdk.ServiceClient, opts CreateResourceShareOpts) (*ResourceShare, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v1/resource-shares
+	var res struct {
+		ResourceShare ResourceShare `json:"resource_share"`
+	}
+	_, err = client.Post(client.ServiceURL("resource-shares"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.ResourceShare, nil
+}
+
+func GetResourceShare(client *golangsdk.ServiceClient, id string) (*ResourceShare, error) {
+	b := map[string]interface{}{
+		"resource_owner":     "self",
+		"resource_share_ids": []string{id},
+	}
+
+	// POST /v1/resource-shares/search
+	var res struct {
+		ResourceShares []ResourceShare `json:"resource_shares"`
+	}
+	_, err := client.Post(client.ServiceURL("resource-shares", "search"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, share := range res.ResourceShares {
+		// deleted shares are still returned for some time
+		if share.ID == id && share.Status != "deleted" {
+			return &share, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func ListResourceShareAssociations(client *golangsdk.ServiceClient, id, associationType string) ([]ResourceShareAssociation, error) {
+	b := map[string]interface{}{
+		"association_type":   associationType,
+		"resource_share_ids": []string{id},
+	}
+
+	// POST /v1/resource-share-associations/search
+	var res struct {
+		Associations []ResourceShareAssociation `json:"resource_share_associations"`
+	}
+	_, err := client.Post(client.ServiceURL("resource-share-associations", "search"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Associations, nil
+}
+
+func UpdateResourceShare(client *golangsdk.ServiceClient, id string, opts UpdateResourceShareOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v1/resource-shares/{resource_share_id}
+	_, err = client.Put(client.ServiceURL("resource-shares", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func AssociateResourceSharePrincipals(client *golangsdk.ServiceClient, id string, principals []string) error {
+	// POST /v1/resource-shares/{resource_share_id}/associate
+	_, err := client.Post(client.ServiceURL("resource-shares", id, "associate"), resourceShareAssociationOpts{Principals: principals}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DisassociateResourceSharePrincipals(client *golangsdk.ServiceClient, id string, principals []string) error {
+	// POST /v1/resource-shares/{resource_share_id}/disassociate
+	_, err := client.Post(client.ServiceURL("resource-shares", id, "disassociate"), resourceShareAssociationOpts{Principals: principals}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeleteResourceShare(client *golangsdk.ServiceClient, id string) error {
+	// DELETE /v1/resource-shares/{resource_share_id}
+	_, err := client.Delete(client.ServiceURL("resource-shares", id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
@@ -0,0 +1,195 @@
+package gaussdb
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type CreateAccountOpts struct {
+	Users []AccountOpts `json:"users" required:"true"`
+}
+
+type AccountOpts struct {
+	// Account name. The value contains 1 to 32 characters.
+	Name string `json:"name" required:"true"`
+	// Host address from which the account can connect, e.g. `%` or `10.0.0.%`.
+	Hosts []string `json:"hosts,omitempty"`
+	// Account password.
+	Password string `json:"password" required:"true"`
+	// Account remarks.
+	Comment string `json:"comment,omitempty"`
+	// Databases the account is granted access to.
+	Databases []AccountDatabase `json:"databases,omitempty"`
+}
+
+type AccountDatabase struct {
+	Name     string `json:"name"`
+	Readonly bool   `json:"readonly"`
+}
+
+func CreateAccount(client *golangsdk.ServiceClient, instanceId string, opts CreateAccountOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users
+	var res jobResponse
+	_, err = client.Post(client.ServiceURL("instances", instanceId, "db-users"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201, 202},
+	})
+	return res.JobId, err
+}
+
+type Account struct {
+	Name      string            `json:"name"`
+	Host      string            `json:"host"`
+	Comment   string            `json:"comment"`
+	Databases []AccountDatabase `json:"databases"`
+}
+
+func ListAccounts(client *golangsdk.ServiceClient, instanceId string) ([]Account, error) {
+	var accounts []Account
+	opts := pageOpts{Limit: 100}
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("instances", instanceId, "db-users").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		// GET https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users
+		var res struct {
+			Users      []Account `json:"users"`
+			TotalCount int       `json:"total_count"`
+		}
+		_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, res.Users...)
+		if len(res.Users) == 0 || len(accounts) >= res.TotalCount {
+			return accounts, nil
+		}
+		opts.Offset += len(res.Users)
+	}
+}
+
+type AccountRef struct {
+	Name string `json:"name"`
+	Host string `json:"host"`
+}
+
+type ResetAccountPasswordOpts struct {
+	Users []AccountPassword `json:"users" required:"true"`
+}
+
+type AccountPassword struct {
+	Name     string `json:"name"`
+	Host     string `json:"host"`
+	Password string `json:"password"`
+}
+
+func ResetAccountPassword(client *golangsdk.ServiceClient, instanceId string, opts ResetAccountPasswordOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// PUT https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users/password
+	var res jobResponse
+	_, err = client.Put(client.ServiceURL("instances", instanceId, "db-users", "password"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return res.JobId, err
+}
+
+type UpdateAccountCommentOpts struct {
+	Users []AccountComment `json:"users" required:"true"`
+}
+
+type AccountComment struct {
+	Name    string `json:"name"`
+	Host    string `json:"host"`
+	Comment string `json:"comment"`
+}
+
+func UpdateAccountComment(client *golangsdk.ServiceClient, instanceId string, opts UpdateAccountCommentOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// PUT https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users/comment
+	var res jobResponse
+	_, err = client.Put(client.ServiceURL("instances", instanceId, "db-users", "comment"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return res.JobId, err
+}
+
+type GrantAccountPrivilegeOpts struct {
+	Users []AccountPrivilege `json:"users" required:"true"`
+}
+
+type AccountPrivilege struct {
+	Name      string            `json:"name"`
+	Host      string            `json:"host"`
+	Databases []AccountDatabase `json:"databases"`
+}
+
+func GrantAccountPrivilege(client *golangsdk.ServiceClient, instanceId string, opts GrantAccountPrivilegeOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users/privilege
+	var res jobResponse
+	_, err = client.Post(client.ServiceURL("instances", instanceId, "db-users", "privilege"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201, 202},
+	})
+	return res.JobId, err
+}
+
+type RevokeAccountPrivilegeOpts struct {
+	Users []AccountRevoke `json:"users" required:"true"`
+}
+
+type AccountRevoke struct {
+	Name      string   `json:"name"`
+	Host      string   `json:"host"`
+	Databases []string `json:"databases"`
+}
+
+func RevokeAccountPrivilege(client *golangsdk.ServiceClient, instanceId string, opts RevokeAccountPrivilegeOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// DELETE https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users/privilege
+	var res jobResponse
+	_, err = client.DeleteWithBodyResp(client.ServiceURL("instances", instanceId, "db-users", "privilege"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return res.JobId, err
+}
+
+type DeleteAccountOpts struct {
+	Users []AccountRef `json:"users" required:"true"`
+}
+
+func DeleteAccount(client *golangsdk.ServiceClient, instanceId string, opts DeleteAccountOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// DELETE https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users
+	var res jobResponse
+	_, err = client.DeleteWithBodyResp(client.ServiceURL("instances", instanceId, "db-users"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return res.JobId, err
+}
@@ -0,0 +1,26 @@
+package gaussdb
+
+import (
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	errCreationV3Client = "error creating OpenTelekomCloud GaussDB client: %w"
+	keyClientV3         = "gaussdb-v3-client"
+)
+
+// jobResponse is returned by the asynchronous GaussDB APIs not covered by gophertelekomcloud.
+type jobResponse struct {
+	JobId string `json:"job_id"`
+}
+
+// waitForGaussJobIfAny waits for the job to complete when the API returned one.
+func waitForGaussJobIfAny(client *golangsdk.ServiceClient, jobId string, timeout time.Duration) error {
+	if jobId == "" {
+		return nil
+	}
+	_, err := waitForGaussJob(client, jobId, int(timeout/time.Second))
+	return err
+}
@@ -0,0 +1,138 @@
+package gaussdb
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceGaussDBFlavorsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGaussDBFlavorsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "gaussdb-mysql",
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "8.0",
+			},
+			"availability_zone_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "single",
+				ValidateFunc: validation.StringInSlice([]string{
+					"single", "multi",
+				}, true),
+			},
+			"vcpus": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"memory": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"spec_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vcpus": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"memory": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"az_status": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGaussDBFlavorsV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GaussDBV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	flavors, err := v3.ShowGaussMySqlFlavors(client, v3.ShowFlavorsOpts{
+		DatabaseName:         d.Get("engine").(string),
+		VersionName:          d.Get("version").(string),
+		AvailabilityZoneMode: d.Get("availability_zone_mode").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error retrieving GaussDB flavors: %w", err)
+	}
+
+	expectedVcpus := d.Get("vcpus").(string)
+	expectedMemory := d.Get("memory").(string)
+	flavorList := make([]map[string]interface{}, 0)
+	for _, item := range flavors {
+		if expectedVcpus != "" && expectedVcpus != item.Vcpus {
+			continue
+		}
+		if expectedMemory != "" && expectedMemory != item.Ram {
+			continue
+		}
+		flavorList = append(flavorList, map[string]interface{}{
+			"spec_code":     item.SpecCode,
+			"type":          item.Type,
+			"vcpus":         item.Vcpus,
+			"memory":        item.Ram,
+			"version":       item.VersionName,
+			"instance_mode": item.InstanceMode,
+			"az_status":     item.AzStatus,
+		})
+	}
+
+	if len(flavorList) < 1 {
+		return fmterr.Errorf("your query returned no results. Please change your search criteria and try again")
+	}
+
+	d.SetId("flavors")
+	mErr := multierror.Append(nil,
+		d.Set("flavors", flavorList),
+		d.Set("region", config.GetRegion(d)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
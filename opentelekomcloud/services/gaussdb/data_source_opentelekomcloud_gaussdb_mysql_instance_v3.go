@@ -0,0 +1,251 @@
+package gaussdb
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/instance"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceGaussDBInstanceV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGaussDBInstanceV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"instance_id", "name"},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dedicated_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"datastore": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"backup_strategy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"keep_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"private_write_ip": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"read_replicas": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     ResourceGaussDBInstanceV3().Schema["nodes"].Elem,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGaussDBInstanceV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GaussDBV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	if instanceId == "" {
+		// filters of the list API are not supported by gophertelekomcloud, so the name is matched here
+		instances, err := instance.ListInstances(client, instance.ListInstancesOpts{})
+		if err != nil {
+			return fmterr.Errorf("error listing GaussDB instances: %w", err)
+		}
+		name := d.Get("name").(string)
+		for _, inst := range instances.Instances {
+			if inst.Name != name {
+				continue
+			}
+			if instanceId != "" {
+				return fmterr.Errorf("your query returned more than one result. Please try a more specific search criteria")
+			}
+			instanceId = inst.Id
+		}
+		if instanceId == "" {
+			return fmterr.Errorf("your query returned no results. Please change your search criteria and try again")
+		}
+	}
+
+	inst, err := instance.GetInstance(client, instanceId)
+	if err != nil {
+		return fmterr.Errorf("error retrieving GaussDB instance %s: %w", instanceId, err)
+	}
+	d.SetId(inst.Id)
+
+	var port int
+	if inst.Port != "" {
+		if port, err = strconv.Atoi(inst.Port); err != nil {
+			return fmterr.Errorf("incorrect port format: %w", err)
+		}
+	}
+
+	var nodes []instance.NodeInfo
+	if inst.Nodes != nil {
+		nodes = *inst.Nodes
+	}
+	nodesList, slaveCount := flattenGaussDBNodes(nodes)
+
+	datastore := []map[string]interface{}{
+		{
+			"engine":  inst.Datastore.Type,
+			"version": inst.Datastore.Version,
+		},
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", inst.Region),
+		d.Set("instance_id", inst.Id),
+		d.Set("project_id", inst.ProjectId),
+		d.Set("name", inst.Name),
+		d.Set("status", inst.Status),
+		d.Set("mode", inst.Type),
+		d.Set("flavor", inst.FlavorRef),
+		d.Set("vpc_id", inst.VpcId),
+		d.Set("subnet_id", inst.SubnetId),
+		d.Set("security_group_id", inst.SecurityGroupId),
+		d.Set("configuration_id", inst.ConfigurationId),
+		d.Set("dedicated_resource_id", inst.DedicatedResourceId),
+		d.Set("db_user_name", inst.DbUserName),
+		d.Set("time_zone", inst.TimeZone),
+		d.Set("availability_zone_mode", inst.AzMode),
+		d.Set("master_availability_zone", inst.MasterAzCode),
+		d.Set("port", port),
+		d.Set("datastore", datastore),
+		d.Set("backup_strategy", flattenGaussDBBackupStrategy(inst.BackupStrategy)),
+		d.Set("private_write_ip", inst.PrivateIps),
+		d.Set("public_ip", inst.PublicIps),
+		d.Set("alias", inst.Alias),
+		d.Set("node_count", inst.NodeCount),
+		d.Set("nodes", nodesList),
+		d.Set("read_replicas", slaveCount),
+		d.Set("created", inst.Created),
+		d.Set("updated", inst.Updated),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
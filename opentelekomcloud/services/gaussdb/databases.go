@@ -0,0 +1,121 @@
+package gaussdb
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// The GaussDB for MySQL database and account APIs are not covered by gophertelekomcloud yet.
+
+type CreateDatabaseOpts struct {
+	Databases []DatabaseOpts `json:"databases" required:"true"`
+}
+
+type DatabaseOpts struct {
+	// Database name. The value contains 1 to 64 characters.
+	Name string `json:"name" required:"true"`
+	// Character set, e.g. `utf8mb4`.
+	CharacterSet string `json:"character_set" required:"true"`
+	// Database remarks.
+	Comment string `json:"comment,omitempty"`
+}
+
+func CreateDatabase(client *golangsdk.ServiceClient, instanceId string, opts CreateDatabaseOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/databases
+	var res jobResponse
+	_, err = client.Post(client.ServiceURL("instances", instanceId, "databases"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201, 202},
+	})
+	return res.JobId, err
+}
+
+type Database struct {
+	Name    string         `json:"name"`
+	Charset string         `json:"charset"`
+	Comment string         `json:"comment"`
+	Users   []DatabaseUser `json:"users"`
+}
+
+type DatabaseUser struct {
+	Name     string `json:"name"`
+	Host     string `json:"host"`
+	Readonly bool   `json:"readonly"`
+}
+
+type pageOpts struct {
+	Offset int `q:"offset"`
+	Limit  int `q:"limit"`
+}
+
+func ListDatabases(client *golangsdk.ServiceClient, instanceId string) ([]Database, error) {
+	var databases []Database
+	opts := pageOpts{Limit: 100}
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("instances", instanceId, "databases").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		// GET https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/databases
+		var res struct {
+			Databases  []Database `json:"databases"`
+			TotalCount int        `json:"total_count"`
+		}
+		_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		databases = append(databases, res.Databases...)
+		if len(res.Databases) == 0 || len(databases) >= res.TotalCount {
+			return databases, nil
+		}
+		opts.Offset += len(res.Databases)
+	}
+}
+
+type UpdateDatabaseCommentOpts struct {
+	Databases []DatabaseComment `json:"database_list" required:"true"`
+}
+
+type DatabaseComment struct {
+	Name    string `json:"name"`
+	Comment string `json:"comment"`
+}
+
+func UpdateDatabaseComment(client *golangsdk.ServiceClient, instanceId string, opts UpdateDatabaseCommentOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// PUT https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/databases/comment
+	var res jobResponse
+	_, err = client.Put(client.ServiceURL("instances", instanceId, "databases", "comment"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return res.JobId, err
+}
+
+type DeleteDatabaseOpts struct {
+	Databases []string `json:"databases" required:"true"`
+}
+
+func DeleteDatabase(client *golangsdk.ServiceClient, instanceId string, opts DeleteDatabaseOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// DELETE https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/databases
+	var res jobResponse
+	_, err = client.DeleteWithBodyResp(client.ServiceURL("instances", instanceId, "databases"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return res.JobId, err
+}
@@ -0,0 +1,102 @@
+package gaussdb
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Only listing of GaussDB for MySQL parameter templates is covered by gophertelekomcloud yet.
+
+type CreateParameterTemplateOpts struct {
+	// Parameter template name. The value contains 1 to 64 characters.
+	Name string `json:"name" required:"true"`
+	// Parameter template description.
+	Description string `json:"description,omitempty"`
+	// Database information.
+	Datastore *ParameterTemplateDatastore `json:"datastore,omitempty"`
+	// Parameter values defined by users based on the default parameter template.
+	ParameterValues map[string]string `json:"parameter_values,omitempty"`
+}
+
+type ParameterTemplateDatastore struct {
+	// Database type. The value is `gaussdb-mysql`.
+	Type string `json:"type" required:"true"`
+	// Database version, e.g. `8.0`.
+	Version string `json:"version" required:"true"`
+}
+
+type ParameterTemplate struct {
+	Id                   string                       `json:"id"`
+	Name                 string                       `json:"name"`
+	Description          string                       `json:"description"`
+	DatastoreVersionName string                       `json:"datastore_version_name"`
+	DatastoreName        string                       `json:"datastore_name"`
+	Created              string                       `json:"created"`
+	Updated              string                       `json:"updated"`
+	Parameters           []ParameterTemplateParameter `json:"configuration_parameters"`
+}
+
+type ParameterTemplateParameter struct {
+	Name            string `json:"name"`
+	Value           string `json:"value"`
+	RestartRequired bool   `json:"restart_required"`
+	Readonly        bool   `json:"readonly"`
+	ValueRange      string `json:"value_range"`
+	Type            string `json:"type"`
+	Description     string `json:"description"`
+}
+
+func CreateParameterTemplate(client *golangsdk.ServiceClient, opts CreateParameterTemplateOpts) (*ParameterTemplate, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/configurations
+	var res struct {
+		Configuration ParameterTemplate `json:"configurations"`
+	}
+	_, err = client.Post(client.ServiceURL("configurations"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Configuration, nil
+}
+
+func GetParameterTemplate(client *golangsdk.ServiceClient, id string) (*ParameterTemplate, error) {
+	// GET https://{Endpoint}/mysql/v3/{project_id}/configurations/{configuration_id}
+	var res ParameterTemplate
+	_, err := client.Get(client.ServiceURL("configurations", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+type UpdateParameterTemplateOpts struct {
+	Name            string            `json:"name,omitempty"`
+	Description     *string           `json:"description,omitempty"`
+	ParameterValues map[string]string `json:"parameter_values,omitempty"`
+}
+
+func UpdateParameterTemplate(client *golangsdk.ServiceClient, id string, opts UpdateParameterTemplateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT https://{Endpoint}/mysql/v3/{project_id}/configurations/{configuration_id}
+	_, err = client.Put(client.ServiceURL("configurations", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeleteParameterTemplate(client *golangsdk.ServiceClient, id string) error {
+	// DELETE https://{Endpoint}/mysql/v3/{project_id}/configurations/{configuration_id}
+	_, err := client.Delete(client.ServiceURL("configurations", id), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
@@ -0,0 +1,163 @@
+package gaussdb
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// The GaussDB for MySQL database proxy APIs are not covered by gophertelekomcloud yet.
+
+type CreateProxyOpts struct {
+	// Proxy specification code, e.g. `gaussdb.proxy.xlarge.x86.2`.
+	FlavorRef string `json:"flavor_ref" required:"true"`
+	// Number of proxy nodes. The value ranges from 2 to 32.
+	NodeNum int `json:"node_num" required:"true"`
+	// Proxy name.
+	Name string `json:"proxy_name,omitempty"`
+	// Proxy mode: `readwrite` (default) or `readonly`.
+	Mode string `json:"proxy_mode,omitempty"`
+	// Routing policy: 0 for weighted load balancing, 1 for load balancing.
+	RouteMode *int `json:"route_mode,omitempty"`
+	// Read weights of the nodes.
+	NodesReadWeight []ProxyNodeWeight `json:"nodes_read_weight,omitempty"`
+}
+
+type ProxyNodeWeight struct {
+	Id     string `json:"id"`
+	Weight int    `json:"weight"`
+}
+
+func CreateProxy(client *golangsdk.ServiceClient, instanceId string, opts CreateProxyOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/proxy
+	var res jobResponse
+	_, err = client.Post(client.ServiceURL("instances", instanceId, "proxy"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201, 202},
+	})
+	return res.JobId, err
+}
+
+type Proxy struct {
+	Id               string      `json:"pool_id"`
+	Name             string      `json:"name"`
+	Status           string      `json:"status"`
+	Address          string      `json:"address"`
+	Port             int         `json:"port"`
+	NodeNum          int         `json:"node_num"`
+	FlavorRef        string      `json:"flavor_ref"`
+	Mode             string      `json:"mode"`
+	RouteMode        int         `json:"route_mode"`
+	DelayThreshold   int         `json:"delay_threshold_in_seconds"`
+	Nodes            []ProxyNode `json:"nodes"`
+	TransactionSplit string      `json:"transaction_split"`
+}
+
+type ProxyNode struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Role   string `json:"role"`
+	AzCode string `json:"az_code"`
+}
+
+type ProxyWeightNode struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Weight int    `json:"weight"`
+}
+
+type ProxyInfo struct {
+	Proxy         Proxy             `json:"proxy"`
+	MasterNode    ProxyWeightNode   `json:"master_node"`
+	ReadonlyNodes []ProxyWeightNode `json:"readonly_nodes"`
+}
+
+func ListProxies(client *golangsdk.ServiceClient, instanceId string) ([]ProxyInfo, error) {
+	// GET https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/proxies
+	var res struct {
+		ProxyList []ProxyInfo `json:"proxy_list"`
+	}
+	_, err := client.Get(client.ServiceURL("instances", instanceId, "proxies"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.ProxyList, nil
+}
+
+// GetProxy returns the proxy with the given ID or golangsdk.ErrDefault404 if there is no such proxy.
+func GetProxy(client *golangsdk.ServiceClient, instanceId, proxyId string) (*ProxyInfo, error) {
+	proxies, err := ListProxies(client, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range proxies {
+		if p.Proxy.Id == proxyId {
+			return &p, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+type UpdateProxyWeightOpts struct {
+	// Read weight of the primary node.
+	MasterWeight *int `json:"master_weight,omitempty"`
+	// Read weights of the read replicas.
+	ReadonlyNodes []ProxyNodeWeight `json:"readonly_nodes,omitempty"`
+}
+
+func UpdateProxyWeight(client *golangsdk.ServiceClient, instanceId, proxyId string, opts UpdateProxyWeightOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// PUT https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/proxy/{proxy_id}/weight
+	var res jobResponse
+	_, err = client.Put(client.ServiceURL("instances", instanceId, "proxy", proxyId, "weight"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return res.JobId, err
+}
+
+type EnlargeProxyOpts struct {
+	// Number of proxy nodes to add.
+	NodeNum int `json:"node_num" required:"true"`
+	// Proxy ID.
+	ProxyId string `json:"proxy_id,omitempty"`
+}
+
+func EnlargeProxy(client *golangsdk.ServiceClient, instanceId string, opts EnlargeProxyOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/proxy/enlarge
+	var res jobResponse
+	_, err = client.Post(client.ServiceURL("instances", instanceId, "proxy", "enlarge"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201, 202},
+	})
+	return res.JobId, err
+}
+
+type DeleteProxyOpts struct {
+	ProxyIds []string `json:"proxy_ids" required:"true"`
+}
+
+func DeleteProxy(client *golangsdk.ServiceClient, instanceId string, opts DeleteProxyOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// DELETE https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/proxy
+	var res jobResponse
+	_, err = client.DeleteWithBodyResp(client.ServiceURL("instances", instanceId, "proxy"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return res.JobId, err
+}
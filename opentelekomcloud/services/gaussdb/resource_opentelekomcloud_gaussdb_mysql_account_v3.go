@@ -0,0 +1,319 @@
+package gaussdb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceGaussDBAccountV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBAccountV3Create,
+		ReadContext:   resourceGaussDBAccountV3Read,
+		UpdateContext: resourceGaussDBAccountV3Update,
+		DeleteContext: resourceGaussDBAccountV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("instance_id", "name", "host"),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "%",
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"databases": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGaussDBAccountV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	createOpts := CreateAccountOpts{
+		Users: []AccountOpts{
+			{
+				Name:      d.Get("name").(string),
+				Hosts:     []string{d.Get("host").(string)},
+				Comment:   d.Get("description").(string),
+				Databases: expandGaussDBAccountDatabases(d.Get("databases").(*schema.Set)),
+			},
+		},
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	createOpts.Users[0].Password = d.Get("password").(string)
+
+	jobId, err := CreateAccount(client, d.Get("instance_id").(string), createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating GaussDB account: %w", err)
+	}
+	if err := waitForGaussJobIfAny(client, jobId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for GaussDB account to be created: %w", err)
+	}
+
+	if err := common.SetComplexID(d, "instance_id", "name", "host"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceGaussDBAccountV3Read(clientCtx, d, meta)
+}
+
+func resourceGaussDBAccountV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	accounts, err := ListAccounts(client, d.Get("instance_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GaussDB accounts")
+	}
+
+	name := d.Get("name").(string)
+	host := d.Get("host").(string)
+	var account *Account
+	for i := range accounts {
+		if accounts[i].Name == name && accounts[i].Host == host {
+			account = &accounts[i]
+			break
+		}
+	}
+	if account == nil {
+		log.Printf("[WARN] GaussDB account %s is not found, removing from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	databases := make([]map[string]interface{}, 0, len(account.Databases))
+	for _, db := range account.Databases {
+		databases = append(databases, map[string]interface{}{
+			"name":     db.Name,
+			"readonly": db.Readonly,
+		})
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("description", account.Comment),
+		d.Set("databases", databases),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGaussDBAccountV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	host := d.Get("host").(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChange("password") {
+		jobId, err := ResetAccountPassword(client, instanceId, ResetAccountPasswordOpts{
+			Users: []AccountPassword{
+				{
+					Name:     name,
+					Host:     host,
+					Password: d.Get("password").(string),
+				},
+			},
+		})
+		if err != nil {
+			return fmterr.Errorf("error resetting GaussDB account %s password: %w", d.Id(), err)
+		}
+		if err := waitForGaussJobIfAny(client, jobId, timeout); err != nil {
+			return fmterr.Errorf("error waiting for GaussDB account %s password to be reset: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("description") {
+		jobId, err := UpdateAccountComment(client, instanceId, UpdateAccountCommentOpts{
+			Users: []AccountComment{
+				{
+					Name:    name,
+					Host:    host,
+					Comment: d.Get("description").(string),
+				},
+			},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating GaussDB account %s description: %w", d.Id(), err)
+		}
+		if err := waitForGaussJobIfAny(client, jobId, timeout); err != nil {
+			return fmterr.Errorf("error waiting for GaussDB account %s description to be updated: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("databases") {
+		oldRaw, newRaw := d.GetChange("databases")
+		oldSet := oldRaw.(*schema.Set)
+		newSet := newRaw.(*schema.Set)
+
+		// a changed readonly flag is a grant of the same database, so revoke only the removed names
+		granted := make(map[string]struct{})
+		for _, db := range expandGaussDBAccountDatabases(newSet) {
+			granted[db.Name] = struct{}{}
+		}
+		var revoked []string
+		for _, db := range expandGaussDBAccountDatabases(oldSet.Difference(newSet)) {
+			if _, ok := granted[db.Name]; !ok {
+				revoked = append(revoked, db.Name)
+			}
+		}
+		if len(revoked) > 0 {
+			jobId, err := RevokeAccountPrivilege(client, instanceId, RevokeAccountPrivilegeOpts{
+				Users: []AccountRevoke{
+					{
+						Name:      name,
+						Host:      host,
+						Databases: revoked,
+					},
+				},
+			})
+			if err != nil {
+				return fmterr.Errorf("error revoking GaussDB account %s privileges: %w", d.Id(), err)
+			}
+			if err := waitForGaussJobIfAny(client, jobId, timeout); err != nil {
+				return fmterr.Errorf("error waiting for GaussDB account %s privileges to be revoked: %w", d.Id(), err)
+			}
+		}
+
+		if toGrant := expandGaussDBAccountDatabases(newSet.Difference(oldSet)); len(toGrant) > 0 {
+			jobId, err := GrantAccountPrivilege(client, instanceId, GrantAccountPrivilegeOpts{
+				Users: []AccountPrivilege{
+					{
+						Name:      name,
+						Host:      host,
+						Databases: toGrant,
+					},
+				},
+			})
+			if err != nil {
+				return fmterr.Errorf("error granting GaussDB account %s privileges: %w", d.Id(), err)
+			}
+			if err := waitForGaussJobIfAny(client, jobId, timeout); err != nil {
+				return fmterr.Errorf("error waiting for GaussDB account %s privileges to be granted: %w", d.Id(), err)
+			}
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceGaussDBAccountV3Read(clientCtx, d, meta)
+}
+
+func resourceGaussDBAccountV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	jobId, err := DeleteAccount(client, d.Get("instance_id").(string), DeleteAccountOpts{
+		Users: []AccountRef{
+			{
+				Name: d.Get("name").(string),
+				Host: d.Get("host").(string),
+			},
+		},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting GaussDB account"))
+	}
+	if err := waitForGaussJobIfAny(client, jobId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error waiting for GaussDB account %s to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGaussDBAccountDatabases(set *schema.Set) []AccountDatabase {
+	databases := make([]AccountDatabase, 0, set.Len())
+	for _, v := range set.List() {
+		db := v.(map[string]interface{})
+		databases = append(databases, AccountDatabase{
+			Name:     db["name"].(string),
+			Readonly: db["readonly"].(bool),
+		})
+	}
+	return databases
+}
@@ -0,0 +1,222 @@
+package gaussdb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceGaussDBDatabaseV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBDatabaseV3Create,
+		ReadContext:   resourceGaussDBDatabaseV3Read,
+		UpdateContext: resourceGaussDBDatabaseV3Update,
+		DeleteContext: resourceGaussDBDatabaseV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("instance_id", "name"),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"character_set": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGaussDBDatabaseV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	createOpts := CreateDatabaseOpts{
+		Databases: []DatabaseOpts{
+			{
+				Name:         d.Get("name").(string),
+				CharacterSet: d.Get("character_set").(string),
+				Comment:      d.Get("description").(string),
+			},
+		},
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	jobId, err := CreateDatabase(client, d.Get("instance_id").(string), createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating GaussDB database: %w", err)
+	}
+	if err := waitForGaussJobIfAny(client, jobId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for GaussDB database to be created: %w", err)
+	}
+
+	if err := common.SetComplexID(d, "instance_id", "name"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceGaussDBDatabaseV3Read(clientCtx, d, meta)
+}
+
+func resourceGaussDBDatabaseV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	databases, err := ListDatabases(client, d.Get("instance_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GaussDB databases")
+	}
+
+	name := d.Get("name").(string)
+	var database *Database
+	for i := range databases {
+		if databases[i].Name == name {
+			database = &databases[i]
+			break
+		}
+	}
+	if database == nil {
+		log.Printf("[WARN] GaussDB database %s is not found, removing from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	users := make([]map[string]interface{}, 0, len(database.Users))
+	for _, user := range database.Users {
+		users = append(users, map[string]interface{}{
+			"name":     user.Name,
+			"host":     user.Host,
+			"readonly": user.Readonly,
+		})
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", database.Name),
+		d.Set("character_set", database.Charset),
+		d.Set("description", database.Comment),
+		d.Set("users", users),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGaussDBDatabaseV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	if d.HasChange("description") {
+		jobId, err := UpdateDatabaseComment(client, d.Get("instance_id").(string), UpdateDatabaseCommentOpts{
+			Databases: []DatabaseComment{
+				{
+					Name:    d.Get("name").(string),
+					Comment: d.Get("description").(string),
+				},
+			},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating GaussDB database %s description: %w", d.Id(), err)
+		}
+		if err := waitForGaussJobIfAny(client, jobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error waiting for GaussDB database %s to be updated: %w", d.Id(), err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceGaussDBDatabaseV3Read(clientCtx, d, meta)
+}
+
+func resourceGaussDBDatabaseV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	jobId, err := DeleteDatabase(client, d.Get("instance_id").(string), DeleteDatabaseOpts{
+		Databases: []string{d.Get("name").(string)},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting GaussDB database"))
+	}
+	if err := waitForGaussJobIfAny(client, jobId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error waiting for GaussDB database %s to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
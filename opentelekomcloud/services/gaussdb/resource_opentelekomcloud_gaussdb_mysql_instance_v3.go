@@ -463,9 +463,35 @@ func resourceGaussDBInstanceV3Read(_ context.Context, d *schema.ResourceData, me
 	}
 
 	// set nodes
+	nodesList, slaveCount := flattenGaussDBNodes(*inst.Nodes)
+
+	mErr = multierror.Append(
+		d.Set("nodes", nodesList),
+		d.Set("read_replicas", slaveCount),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// set backup_strategy
+	backupStrategyList := flattenGaussDBBackupStrategy(inst.BackupStrategy)
+	mErr = multierror.Append(d.Set("backup_strategy", backupStrategyList))
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// flattenGaussDBNodes returns the nodes of the instance and the number of the available read replicas.
+func flattenGaussDBNodes(nodes []instance.NodeInfo) ([]map[string]interface{}, int) {
 	slaveCount := 0
-	nodesList := make([]map[string]interface{}, 0, 1)
-	for _, raw := range *inst.Nodes {
+	nodesList := make([]map[string]interface{}, 0, len(nodes))
+	for _, raw := range nodes {
 		node := map[string]interface{}{
 			"id":              raw.Id,
 			"name":            raw.Name,
@@ -491,34 +517,17 @@ func resourceGaussDBInstanceV3Read(_ context.Context, d *schema.ResourceData, me
 			slaveCount += 1
 		}
 	}
+	return nodesList, slaveCount
+}
 
-	mErr = multierror.Append(
-		d.Set("nodes", nodesList),
-		d.Set("read_replicas", slaveCount),
-	)
-	if err := mErr.ErrorOrNil(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// set backup_strategy
-	backupStrategyList := make([]map[string]interface{}, 1)
+func flattenGaussDBBackupStrategy(strategy instance.BackupStrategy) []map[string]interface{} {
 	backupStrategy := map[string]interface{}{
-		"start_time": inst.BackupStrategy.StartTime,
+		"start_time": strategy.StartTime,
 	}
-	if days, err := strconv.Atoi(inst.BackupStrategy.KeepDays); err == nil {
+	if days, err := strconv.Atoi(strategy.KeepDays); err == nil {
 		backupStrategy["keep_days"] = days
 	}
-	backupStrategyList[0] = backupStrategy
-	mErr = multierror.Append(d.Set("backup_strategy", backupStrategyList))
-	if err := mErr.ErrorOrNil(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	if err := mErr.ErrorOrNil(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+	return []map[string]interface{}{backupStrategy}
 }
 
 func resourceGaussDBInstanceV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
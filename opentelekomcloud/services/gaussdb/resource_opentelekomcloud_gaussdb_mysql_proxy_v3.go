@@ -0,0 +1,350 @@
+package gaussdb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceGaussDBProxyV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBProxyV3Create,
+		ReadContext:   resourceGaussDBProxyV3Read,
+		UpdateContext: resourceGaussDBProxyV3Update,
+		DeleteContext: resourceGaussDBProxyV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("instance_id", "id"),
+		},
+
+		CustomizeDiff: customdiff.ForceNewIfChange("node_num", func(_ context.Context, old, new, _ interface{}) bool {
+			return new.(int) < old.(int)
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_num": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(2, 32),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"proxy_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "readwrite",
+				ValidateFunc: validation.StringInSlice([]string{
+					"readwrite", "readonly",
+				}, false),
+			},
+			"master_node_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+			"readonly_nodes_weight": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delay_threshold": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"az_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGaussDBProxyV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	existing, err := ListProxies(client, instanceId)
+	if err != nil {
+		return fmterr.Errorf("error listing GaussDB proxies: %w", err)
+	}
+
+	createOpts := CreateProxyOpts{
+		FlavorRef:       d.Get("flavor").(string),
+		NodeNum:         d.Get("node_num").(int),
+		Name:            d.Get("name").(string),
+		Mode:            d.Get("proxy_mode").(string),
+		NodesReadWeight: expandGaussDBProxyWeights(d),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	jobId, err := CreateProxy(client, instanceId, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating GaussDB proxy: %w", err)
+	}
+	if err := waitForGaussJobIfAny(client, jobId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for GaussDB proxy to be created: %w", err)
+	}
+
+	proxies, err := ListProxies(client, instanceId)
+	if err != nil {
+		return fmterr.Errorf("error listing GaussDB proxies: %w", err)
+	}
+	proxyId := newGaussDBProxyId(existing, proxies)
+	if proxyId == "" {
+		return fmterr.Errorf("unable to find the created GaussDB proxy of instance %s", instanceId)
+	}
+	d.SetId(proxyId)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceGaussDBProxyV3Read(clientCtx, d, meta)
+}
+
+func resourceGaussDBProxyV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	info, err := GetProxy(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GaussDB proxy")
+	}
+
+	nodes := make([]map[string]interface{}, 0, len(info.Proxy.Nodes))
+	for _, node := range info.Proxy.Nodes {
+		nodes = append(nodes, map[string]interface{}{
+			"id":      node.Id,
+			"name":    node.Name,
+			"role":    node.Role,
+			"az_code": node.AzCode,
+			"status":  node.Status,
+		})
+	}
+	weights := make([]map[string]interface{}, 0, len(info.ReadonlyNodes))
+	for _, node := range info.ReadonlyNodes {
+		weights = append(weights, map[string]interface{}{
+			"id":     node.Id,
+			"weight": node.Weight,
+		})
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", info.Proxy.Name),
+		d.Set("flavor", info.Proxy.FlavorRef),
+		d.Set("node_num", info.Proxy.NodeNum),
+		d.Set("proxy_mode", info.Proxy.Mode),
+		d.Set("address", info.Proxy.Address),
+		d.Set("port", info.Proxy.Port),
+		d.Set("status", info.Proxy.Status),
+		d.Set("delay_threshold", info.Proxy.DelayThreshold),
+		d.Set("nodes", nodes),
+		d.Set("master_node_weight", info.MasterNode.Weight),
+		d.Set("readonly_nodes_weight", weights),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGaussDBProxyV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+	instanceId := d.Get("instance_id").(string)
+
+	if d.HasChange("node_num") {
+		oldNum, newNum := d.GetChange("node_num")
+		jobId, err := EnlargeProxy(client, instanceId, EnlargeProxyOpts{
+			NodeNum: newNum.(int) - oldNum.(int),
+			ProxyId: d.Id(),
+		})
+		if err != nil {
+			return fmterr.Errorf("error scaling GaussDB proxy %s: %w", d.Id(), err)
+		}
+		if err := waitForGaussJobIfAny(client, jobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error waiting for GaussDB proxy %s to be scaled: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("master_node_weight", "readonly_nodes_weight") {
+		weightOpts := UpdateProxyWeightOpts{
+			MasterWeight:  pointerto.Int(d.Get("master_node_weight").(int)),
+			ReadonlyNodes: expandGaussDBProxyReadonlyWeights(d),
+		}
+		log.Printf("[DEBUG] Update Weight Options: %#v", weightOpts)
+
+		jobId, err := UpdateProxyWeight(client, instanceId, d.Id(), weightOpts)
+		if err != nil {
+			return fmterr.Errorf("error updating GaussDB proxy %s weights: %w", d.Id(), err)
+		}
+		if err := waitForGaussJobIfAny(client, jobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error waiting for GaussDB proxy %s weights to be updated: %w", d.Id(), err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceGaussDBProxyV3Read(clientCtx, d, meta)
+}
+
+func resourceGaussDBProxyV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.GaussDBV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	jobId, err := DeleteProxy(client, d.Get("instance_id").(string), DeleteProxyOpts{
+		ProxyIds: []string{d.Id()},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error retrieving GaussDB proxy"))
+	}
+	if err := waitForGaussJobIfAny(client, jobId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error waiting for GaussDB proxy %s to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandGaussDBProxyWeights returns read weights of all nodes configured for the proxy creation.
+func expandGaussDBProxyWeights(d *schema.ResourceData) []ProxyNodeWeight {
+	weights := expandGaussDBProxyReadonlyWeights(d)
+	if v, ok := d.GetOk("master_node_weight"); ok {
+		// the primary node is addressed by the instance ID
+		weights = append(weights, ProxyNodeWeight{
+			Id:     d.Get("instance_id").(string),
+			Weight: v.(int),
+		})
+	}
+	return weights
+}
+
+func expandGaussDBProxyReadonlyWeights(d *schema.ResourceData) []ProxyNodeWeight {
+	raw := d.Get("readonly_nodes_weight").(*schema.Set).List()
+	weights := make([]ProxyNodeWeight, 0, len(raw))
+	for _, v := range raw {
+		node := v.(map[string]interface{})
+		weights = append(weights, ProxyNodeWeight{
+			Id:     node["id"].(string),
+			Weight: node["weight"].(int),
+		})
+	}
+	return weights
+}
+
+func newGaussDBProxyId(before, after []ProxyInfo) string {
+	known := make(map[string]struct{}, len(before))
+	for _, p := range before {
+		known[p.Proxy.Id] = struct{}{}
+	}
+	for _, p := range after {
+		if _, ok := known[p.Proxy.Id]; !ok {
+			return p.Proxy.Id
+		}
+	}
+	return ""
+}
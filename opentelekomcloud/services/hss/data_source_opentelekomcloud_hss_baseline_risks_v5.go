@@ -0,0 +1,169 @@
+package hss
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceBaselineRisks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBaselineRisksRead,
+
+		Schema: map[string]*schema.Schema{
+			"check_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Baseline name, e.g. SSH, CentOS 7, Windows.",
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Risk level. Possible values: Security, Low, Medium, High.",
+			},
+			"standard": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Standard type. Possible values: cn_standard, hw_standard.",
+			},
+			"host_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Host ID. If it is not specified, all hosts are queried.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Enterprise project ID. To query all enterprise projects, set this parameter to all_granted_eps.",
+			},
+			"risks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of baseline configuration risks returned from the query.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"check_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Baseline name.",
+						},
+						"check_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Baseline type.",
+						},
+						"check_type_desc": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Baseline description.",
+						},
+						"standard": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Standard type.",
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Risk level.",
+						},
+						"check_rule_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of check items.",
+						},
+						"failed_rule_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of failed check items.",
+						},
+						"host_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of affected servers.",
+						},
+						"scan_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Last check time, in milliseconds.",
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBaselineRisksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, hssClientV5, func() (*golangsdk.ServiceClient, error) {
+		return config.HssV5Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV5Client, err)
+	}
+
+	opts := ListBaselineRisksOpts{
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+		CheckName:           d.Get("check_name").(string),
+		Severity:            d.Get("severity").(string),
+		Standard:            d.Get("standard").(string),
+		HostID:              d.Get("host_id").(string),
+	}
+	allRisks, err := ListBaselineRisks(client, opts)
+	if err != nil {
+		return diag.Errorf("unable to list OpenTelekomCloud HSS baseline risks: %s", err)
+	}
+
+	if len(allRisks) == 0 {
+		log.Printf("[DEBUG] No HSS baseline risks in OpenTelekomCloud found")
+	}
+
+	uuId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+
+	d.SetId(uuId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("risks", flattenBaselineRisks(allRisks)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenBaselineRisks(risks []BaselineRisk) []interface{} {
+	if len(risks) == 0 {
+		return nil
+	}
+
+	rst := make([]interface{}, 0, len(risks))
+	for _, r := range risks {
+		rst = append(rst, map[string]interface{}{
+			"check_name":      r.CheckName,
+			"check_type":      r.CheckType,
+			"check_type_desc": r.CheckTypeDesc,
+			"standard":        r.Standard,
+			"severity":        r.Severity,
+			"check_rule_num":  r.CheckRuleNum,
+			"failed_rule_num": r.FailedRuleNum,
+			"host_num":        r.HostNum,
+			"scan_time":       r.ScanTime,
+		})
+	}
+	return rst
+}
@@ -0,0 +1,251 @@
+package hss
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceVulnerabilities() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVulnerabilitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Vulnerability type. Possible values: linux_vul, windows_vul, web_cms, app_vul.",
+			},
+			"vul_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Vulnerability ID.",
+			},
+			"vul_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Vulnerability name.",
+			},
+			"repair_priority": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Fixing priority. Possible values: Critical, High, Medium, Low.",
+			},
+			"handle_status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Handling status. Possible values: unhandled, handled.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Vulnerability fixing status, e.g. vul_status_unfix, vul_status_fixed, vul_status_ignored.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Enterprise project ID. To query all enterprise projects, set this parameter to all_granted_eps.",
+			},
+			"vulnerabilities": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of vulnerabilities returned from the query.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Vulnerability ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Vulnerability name.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Vulnerability type.",
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Vulnerability tags.",
+						},
+						"severity_level": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Risk level. Possible values: Critical, High, Medium, Low.",
+						},
+						"repair_necessity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Fixing urgency.",
+						},
+						"repair_priority": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Fixing priority.",
+						},
+						"host_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of affected servers.",
+						},
+						"unhandled_host_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of servers where the vulnerability is not handled.",
+						},
+						"fixed_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of fixed vulnerabilities.",
+						},
+						"ignored_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of ignored vulnerabilities.",
+						},
+						"verify_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of vulnerabilities being verified.",
+						},
+						"scan_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Last scan time, in milliseconds.",
+						},
+						"solution_detail": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Fixing solution.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL of the vulnerability details.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Vulnerability description.",
+						},
+						"cves": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of CVEs related to the vulnerability.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cve_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "CVE ID.",
+									},
+									"cvss": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "CVSS score.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVulnerabilitiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, hssClientV5, func() (*golangsdk.ServiceClient, error) {
+		return config.HssV5Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV5Client, err)
+	}
+
+	opts := ListVulnerabilitiesOpts{
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+		Type:                d.Get("type").(string),
+		VulID:               d.Get("vul_id").(string),
+		VulName:             d.Get("vul_name").(string),
+		RepairPriority:      d.Get("repair_priority").(string),
+		HandleStatus:        d.Get("handle_status").(string),
+		Status:              d.Get("status").(string),
+	}
+	allVulnerabilities, err := ListVulnerabilities(client, opts)
+	if err != nil {
+		return diag.Errorf("unable to list OpenTelekomCloud HSS vulnerabilities: %s", err)
+	}
+
+	if len(allVulnerabilities) == 0 {
+		log.Printf("[DEBUG] No HSS vulnerabilities in OpenTelekomCloud found")
+	}
+
+	uuId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+
+	d.SetId(uuId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("vulnerabilities", flattenVulnerabilities(allVulnerabilities)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenVulnerabilities(vulnerabilities []Vulnerability) []interface{} {
+	if len(vulnerabilities) == 0 {
+		return nil
+	}
+
+	rst := make([]interface{}, 0, len(vulnerabilities))
+	for _, v := range vulnerabilities {
+		cves := make([]interface{}, 0, len(v.CveList))
+		for _, cve := range v.CveList {
+			cves = append(cves, map[string]interface{}{
+				"cve_id": cve.CveID,
+				"cvss":   cve.Cvss,
+			})
+		}
+		rst = append(rst, map[string]interface{}{
+			"id":                 v.ID,
+			"name":               v.Name,
+			"type":               v.Type,
+			"labels":             v.Labels,
+			"severity_level":     v.SeverityLevel,
+			"repair_necessity":   v.RepairNecessity,
+			"repair_priority":    v.RepairPriority,
+			"host_num":           v.HostNum,
+			"unhandled_host_num": v.UnhandledHostNum,
+			"fixed_num":          v.FixedNum,
+			"ignored_num":        v.IgnoredNum,
+			"verify_num":         v.VerifyNum,
+			"scan_time":          v.ScanTime,
+			"solution_detail":    v.SolutionDetail,
+			"url":                v.Url,
+			"description":        v.Description,
+			"cves":               cves,
+		})
+	}
+	return rst
+}
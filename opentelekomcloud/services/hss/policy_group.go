@@ -0,0 +1,137 @@
+package hss
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// HSS policy group management is not covered by gophertelekomcloud yet.
+
+const listPageLimit = 200
+
+type ListPolicyGroupsOpts struct {
+	EnterpriseProjectID string `q:"enterprise_project_id,omitempty"`
+	GroupName           string `q:"group_name,omitempty"`
+	Offset              int    `q:"offset"`
+	// Number of records on each page, maximum is 200
+	Limit int `q:"limit,omitempty"`
+}
+
+type PolicyGroup struct {
+	ID             string   `json:"group_id"`
+	Name           string   `json:"group_name"`
+	Description    string   `json:"description"`
+	Deletable      bool     `json:"deletable"`
+	HostNum        int      `json:"host_num"`
+	DefaultGroup   bool     `json:"default_group"`
+	SupportOS      string   `json:"support_os"`
+	SupportVersion []string `json:"support_version"`
+}
+
+type CreatePolicyGroupOpts struct {
+	EnterpriseProjectID string `json:"-" q:"enterprise_project_id,omitempty"`
+	// ID of the policy group which policies are copied from
+	GroupID     string `json:"group_id" required:"true"`
+	GroupName   string `json:"group_name" required:"true"`
+	Description string `json:"description,omitempty"`
+}
+
+type DeletePolicyGroupOpts struct {
+	EnterpriseProjectID string `json:"-" q:"enterprise_project_id,omitempty"`
+	GroupID             string `json:"group_id" required:"true"`
+}
+
+type DeployPolicyGroupOpts struct {
+	EnterpriseProjectID string   `json:"-" q:"enterprise_project_id,omitempty"`
+	TargetPolicyGroupID string   `json:"target_policy_group_id" required:"true"`
+	OperateAll          bool     `json:"operate_all"`
+	HostIDs             []string `json:"host_id_list,omitempty"`
+}
+
+// ListPolicyGroups returns all policy groups, going through all the pages
+func ListPolicyGroups(client *golangsdk.ServiceClient, opts ListPolicyGroupsOpts) ([]PolicyGroup, error) {
+	if opts.Limit == 0 {
+		opts.Limit = listPageLimit
+	}
+	opts.Offset = 0
+
+	var groups []PolicyGroup
+	for {
+		q, err := golangsdk.BuildQueryString(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v5/{project_id}/policy/groups
+		var res struct {
+			TotalNum int           `json:"total_num"`
+			DataList []PolicyGroup `json:"data_list"`
+		}
+		_, err = client.Get(client.ServiceURL("policy", "groups")+q.String(), &res, &golangsdk.RequestOpts{
+			OkCodes:     []int{200},
+			MoreHeaders: map[string]string{"region": client.RegionID},
+		})
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, res.DataList...)
+		if len(res.DataList) == 0 || len(groups) >= res.TotalNum {
+			return groups, nil
+		}
+		opts.Offset += opts.Limit
+	}
+}
+
+func CreatePolicyGroup(client *golangsdk.ServiceClient, opts CreatePolicyGroupOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return err
+	}
+
+	// POST /v5/{project_id}/policy/group
+	_, err = client.Post(client.ServiceURL("policy", "group")+q.String(), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: map[string]string{"region": client.RegionID},
+	})
+	return err
+}
+
+func DeletePolicyGroup(client *golangsdk.ServiceClient, opts DeletePolicyGroupOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return err
+	}
+
+	// DELETE /v5/{project_id}/policy/group
+	_, err = client.DeleteWithBody(client.ServiceURL("policy", "group")+q.String(), b, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: map[string]string{"region": client.RegionID},
+	})
+	return err
+}
+
+// DeployPolicyGroup applies the policy group to the hosts, each host can belong to a single policy group only
+func DeployPolicyGroup(client *golangsdk.ServiceClient, opts DeployPolicyGroupOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return err
+	}
+
+	// POST /v5/{project_id}/policy/deploy
+	_, err = client.Post(client.ServiceURL("policy", "deploy")+q.String(), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: map[string]string{"region": client.RegionID},
+	})
+	return err
+}
@@ -0,0 +1,251 @@
+package hss
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/hss/v5/host"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourcePolicyGroupBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyGroupBindingCreate,
+		ReadContext:   resourcePolicyGroupBindingRead,
+		UpdateContext: resourcePolicyGroupBindingUpdate,
+		DeleteContext: resourcePolicyGroupBindingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePolicyGroupBindingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePolicyGroupBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, hssClientV5, func() (*golangsdk.ServiceClient, error) {
+		return config.HssV5Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV5Client, err)
+	}
+
+	groupId := d.Get("policy_group_id").(string)
+	err = DeployPolicyGroup(client, DeployPolicyGroupOpts{
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+		TargetPolicyGroupID: groupId,
+		HostIDs:             common.ExpandToStringListBySet(d.Get("host_ids").(*schema.Set)),
+	})
+	if err != nil {
+		return diag.Errorf("error binding hosts to OpenTelekomCloud HSS policy group (%s): %s", groupId, err)
+	}
+	d.SetId(groupId)
+
+	clientCtx := common.CtxWithClient(ctx, client, hssClientV5)
+	return resourcePolicyGroupBindingRead(clientCtx, d, meta)
+}
+
+func resourcePolicyGroupBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, hssClientV5, func() (*golangsdk.ServiceClient, error) {
+		return config.HssV5Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV5Client, err)
+	}
+
+	if _, err := QueryPolicyGroupById(client, d.Get("enterprise_project_id").(string), d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "OpenTelekomCloud HSS policy group binding")
+	}
+
+	hostIds, err := listPolicyGroupHosts(client, d.Id())
+	if err != nil {
+		return diag.Errorf("error fetching hosts of OpenTelekomCloud HSS policy group (%s): %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Hosts bound to OpenTelekomCloud HSS policy group (%s): %#v", d.Id(), hostIds)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("policy_group_id", d.Id()),
+		d.Set("host_ids", hostIds),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving OpenTelekomCloud HSS policy group binding fields: %s", err)
+	}
+	return nil
+}
+
+func resourcePolicyGroupBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, hssClientV5, func() (*golangsdk.ServiceClient, error) {
+		return config.HssV5Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV5Client, err)
+	}
+
+	oldRaw, newRaw := d.GetChange("host_ids")
+	oldHosts, newHosts := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+	if added := newHosts.Difference(oldHosts); added.Len() > 0 {
+		err = DeployPolicyGroup(client, DeployPolicyGroupOpts{
+			EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+			TargetPolicyGroupID: d.Id(),
+			HostIDs:             common.ExpandToStringListBySet(added),
+		})
+		if err != nil {
+			return diag.Errorf("error binding hosts to OpenTelekomCloud HSS policy group (%s): %s", d.Id(), err)
+		}
+	}
+	if removed := oldHosts.Difference(newHosts); removed.Len() > 0 {
+		err = unbindPolicyGroupHosts(client, d.Get("enterprise_project_id").(string), d.Id(), common.ExpandToStringListBySet(removed))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, hssClientV5)
+	return resourcePolicyGroupBindingRead(clientCtx, d, meta)
+}
+
+func resourcePolicyGroupBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, hssClientV5, func() (*golangsdk.ServiceClient, error) {
+		return config.HssV5Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV5Client, err)
+	}
+
+	hostIds := common.ExpandToStringListBySet(d.Get("host_ids").(*schema.Set))
+	if len(hostIds) == 0 {
+		return nil
+	}
+	err = unbindPolicyGroupHosts(client, d.Get("enterprise_project_id").(string), d.Id(), hostIds)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "OpenTelekomCloud HSS policy group binding")
+	}
+	return nil
+}
+
+func resourcePolicyGroupBindingImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("policy_group_id", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// listPolicyGroupHosts returns IDs of all the hosts of the policy group, going through all the pages
+func listPolicyGroupHosts(client *golangsdk.ServiceClient, groupId string) ([]string, error) {
+	hostIds := make([]string, 0)
+	offset := 0
+	for {
+		hosts, err := host.ListHost(client, host.ListHostOpts{
+			Offset:        &offset,
+			Limit:         listPageLimit,
+			PolicyGroupId: groupId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range hosts {
+			hostIds = append(hostIds, h.ID)
+		}
+		if len(hosts) < listPageLimit {
+			return hostIds, nil
+		}
+		offset += listPageLimit
+	}
+}
+
+// unbindPolicyGroupHosts moves hosts back to the default policy group, as HSS doesn't allow
+// hosts without any policy group
+func unbindPolicyGroupHosts(client *golangsdk.ServiceClient, enterpriseProjectID, groupId string, hostIds []string) error {
+	defaultGroup, err := queryDefaultPolicyGroup(client, enterpriseProjectID, groupId)
+	if err != nil {
+		return err
+	}
+	err = DeployPolicyGroup(client, DeployPolicyGroupOpts{
+		EnterpriseProjectID: enterpriseProjectID,
+		TargetPolicyGroupID: defaultGroup.ID,
+		HostIDs:             hostIds,
+	})
+	if err != nil {
+		return fmt.Errorf("error moving hosts to OpenTelekomCloud HSS default policy group (%s): %s", defaultGroup.ID, err)
+	}
+	return nil
+}
+
+// queryDefaultPolicyGroup finds default group for the same OS and edition as the given policy group
+func queryDefaultPolicyGroup(client *golangsdk.ServiceClient, enterpriseProjectID, groupId string) (*PolicyGroup, error) {
+	groups, err := ListPolicyGroups(client, ListPolicyGroupsOpts{
+		EnterpriseProjectID: enterpriseProjectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching OpenTelekomCloud HSS policy groups: %s", err)
+	}
+
+	var target *PolicyGroup
+	for i := range groups {
+		if groups[i].ID == groupId {
+			target = &groups[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, golangsdk.ErrDefault404{
+			ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+				Body: []byte(fmt.Sprintf("the OpenTelekomCloud HSS policy group (%s) does not exist", groupId)),
+			},
+		}
+	}
+
+	var fallback *PolicyGroup
+	for i := range groups {
+		g := &groups[i]
+		if !g.DefaultGroup || g.SupportOS != target.SupportOS {
+			continue
+		}
+		for _, version := range g.SupportVersion {
+			if common.StrSliceContains(target.SupportVersion, version) {
+				return g, nil
+			}
+		}
+		if fallback == nil {
+			fallback = g
+		}
+	}
+	if fallback == nil {
+		return nil, fmt.Errorf("no OpenTelekomCloud HSS default policy group found for %s hosts", target.SupportOS)
+	}
+	return fallback, nil
+}
@@ -0,0 +1,186 @@
+package hss
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourcePolicyGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyGroupCreate,
+		ReadContext:   resourcePolicyGroupRead,
+		DeleteContext: resourcePolicyGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"host_num": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"deletable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"default_group": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"support_os": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_version": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePolicyGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, hssClientV5, func() (*golangsdk.ServiceClient, error) {
+		return config.HssV5Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV5Client, err)
+	}
+
+	groupName := d.Get("name").(string)
+	err = CreatePolicyGroup(client, CreatePolicyGroupOpts{
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+		GroupID:             d.Get("source_group_id").(string),
+		GroupName:           groupName,
+		Description:         d.Get("description").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error creating OpenTelekomCloud HSS policy group: %s", err)
+	}
+
+	// creation response doesn't contain the ID of the new group
+	groups, err := ListPolicyGroups(client, ListPolicyGroupsOpts{
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+		GroupName:           groupName,
+	})
+	if err != nil {
+		return diag.Errorf("error fetching OpenTelekomCloud HSS policy groups: %s", err)
+	}
+	for _, g := range groups {
+		if g.Name == groupName {
+			d.SetId(g.ID)
+			break
+		}
+	}
+	if d.Id() == "" {
+		return diag.Errorf("error creating OpenTelekomCloud HSS policy group: group %s not found after creation", groupName)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, hssClientV5)
+	return resourcePolicyGroupRead(clientCtx, d, meta)
+}
+
+func QueryPolicyGroupById(client *golangsdk.ServiceClient, enterpriseProjectID, groupId string) (*PolicyGroup, error) {
+	groups, err := ListPolicyGroups(client, ListPolicyGroupsOpts{
+		EnterpriseProjectID: enterpriseProjectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching OpenTelekomCloud HSS policy groups: %s", err)
+	}
+	for _, g := range groups {
+		if g.ID == groupId {
+			return &g, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{
+		ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+			Body: []byte(fmt.Sprintf("the OpenTelekomCloud HSS policy group (%s) does not exist", groupId)),
+		},
+	}
+}
+
+func resourcePolicyGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, hssClientV5, func() (*golangsdk.ServiceClient, error) {
+		return config.HssV5Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV5Client, err)
+	}
+
+	g, err := QueryPolicyGroupById(client, d.Get("enterprise_project_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "OpenTelekomCloud HSS policy group")
+	}
+	log.Printf("[DEBUG] The response of OpenTelekomCloud HSS policy group is: %#v", g)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", g.Name),
+		d.Set("description", g.Description),
+		d.Set("host_num", g.HostNum),
+		d.Set("deletable", g.Deletable),
+		d.Set("default_group", g.DefaultGroup),
+		d.Set("support_os", g.SupportOS),
+		d.Set("support_version", g.SupportVersion),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving OpenTelekomCloud HSS policy group fields: %s", err)
+	}
+	return nil
+}
+
+func resourcePolicyGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, hssClientV5, func() (*golangsdk.ServiceClient, error) {
+		return config.HssV5Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV5Client, err)
+	}
+
+	err = DeletePolicyGroup(client, DeletePolicyGroupOpts{
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+		GroupID:             d.Id(),
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting OpenTelekomCloud HSS policy group")
+	}
+	return nil
+}
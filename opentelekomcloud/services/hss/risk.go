@@ -0,0 +1,138 @@
+package hss
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// HSS vulnerability and baseline check results are not covered by gophertelekomcloud yet.
+
+type ListVulnerabilitiesOpts struct {
+	EnterpriseProjectID string `q:"enterprise_project_id,omitempty"`
+	// Vulnerability type: linux_vul, windows_vul, web_cms or app_vul
+	Type           string `q:"type,omitempty"`
+	VulID          string `q:"vul_id,omitempty"`
+	VulName        string `q:"vul_name,omitempty"`
+	RepairPriority string `q:"repair_priority,omitempty"`
+	HandleStatus   string `q:"handle_status,omitempty"`
+	Status         string `q:"status,omitempty"`
+	Offset         int    `q:"offset"`
+	// Number of records on each page, maximum is 200
+	Limit int `q:"limit,omitempty"`
+}
+
+type VulnerabilityCVE struct {
+	CveID string  `json:"cve_id"`
+	Cvss  float64 `json:"cvss"`
+}
+
+type Vulnerability struct {
+	ID               string             `json:"vul_id"`
+	Name             string             `json:"vul_name"`
+	Type             string             `json:"type"`
+	Labels           []string           `json:"label_list"`
+	RepairNecessity  string             `json:"repair_necessity"`
+	SeverityLevel    string             `json:"severity_level"`
+	RepairPriority   string             `json:"repair_priority"`
+	HostNum          int                `json:"host_num"`
+	UnhandledHostNum int                `json:"unhandle_host_num"`
+	FixedNum         int                `json:"fixed_num"`
+	IgnoredNum       int                `json:"ignored_num"`
+	VerifyNum        int                `json:"verify_num"`
+	ScanTime         int64              `json:"scan_time"`
+	SolutionDetail   string             `json:"solution_detail"`
+	Url              string             `json:"url"`
+	Description      string             `json:"description"`
+	CveList          []VulnerabilityCVE `json:"cve_list"`
+}
+
+type ListBaselineRisksOpts struct {
+	EnterpriseProjectID string `q:"enterprise_project_id,omitempty"`
+	CheckName           string `q:"check_name,omitempty"`
+	Severity            string `q:"severity,omitempty"`
+	// Baseline standard: cn_standard or hw_standard
+	Standard string `q:"standard,omitempty"`
+	HostID   string `q:"host_id,omitempty"`
+	Offset   int    `q:"offset"`
+	// Number of records on each page, maximum is 200
+	Limit int `q:"limit,omitempty"`
+}
+
+type BaselineRisk struct {
+	CheckName     string `json:"check_name"`
+	CheckType     string `json:"check_type"`
+	CheckTypeDesc string `json:"check_type_desc"`
+	Standard      string `json:"standard"`
+	Severity      string `json:"severity"`
+	CheckRuleNum  int    `json:"check_rule_num"`
+	FailedRuleNum int    `json:"failed_rule_num"`
+	HostNum       int    `json:"host_num"`
+	ScanTime      int64  `json:"scan_time"`
+}
+
+// ListVulnerabilities returns all detected vulnerabilities, going through all the pages
+func ListVulnerabilities(client *golangsdk.ServiceClient, opts ListVulnerabilitiesOpts) ([]Vulnerability, error) {
+	if opts.Limit == 0 {
+		opts.Limit = listPageLimit
+	}
+	opts.Offset = 0
+
+	var vulnerabilities []Vulnerability
+	for {
+		q, err := golangsdk.BuildQueryString(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v5/{project_id}/vulnerability/vulnerabilities
+		var res struct {
+			TotalNum int             `json:"total_num"`
+			DataList []Vulnerability `json:"data_list"`
+		}
+		_, err = client.Get(client.ServiceURL("vulnerability", "vulnerabilities")+q.String(), &res, &golangsdk.RequestOpts{
+			OkCodes:     []int{200},
+			MoreHeaders: map[string]string{"region": client.RegionID},
+		})
+		if err != nil {
+			return nil, err
+		}
+		vulnerabilities = append(vulnerabilities, res.DataList...)
+		if len(res.DataList) == 0 || len(vulnerabilities) >= res.TotalNum {
+			return vulnerabilities, nil
+		}
+		opts.Offset += opts.Limit
+	}
+}
+
+// ListBaselineRisks returns all failed configuration checks, going through all the pages
+func ListBaselineRisks(client *golangsdk.ServiceClient, opts ListBaselineRisksOpts) ([]BaselineRisk, error) {
+	if opts.Limit == 0 {
+		opts.Limit = listPageLimit
+	}
+	opts.Offset = 0
+
+	var risks []BaselineRisk
+	for {
+		q, err := golangsdk.BuildQueryString(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v5/{project_id}/baseline/risk-configs
+		var res struct {
+			TotalNum int            `json:"total_num"`
+			DataList []BaselineRisk `json:"data_list"`
+		}
+		_, err = client.Get(client.ServiceURL("baseline", "risk-configs")+q.String(), &res, &golangsdk.RequestOpts{
+			OkCodes:     []int{200},
+			MoreHeaders: map[string]string{"region": client.RegionID},
+		})
+		if err != nil {
+			return nil, err
+		}
+		risks = append(risks, res.DataList...)
+		if len(res.DataList) == 0 || len(risks) >= res.TotalNum {
+			return risks, nil
+		}
+		opts.Offset += opts.Limit
+	}
+}
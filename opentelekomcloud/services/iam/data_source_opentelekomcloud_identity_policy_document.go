@@ -0,0 +1,217 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+type policyDocumentStatement struct {
+	Sid       string                         `json:"Sid,omitempty"`
+	Effect    string                         `json:"Effect"`
+	Principal map[string][]string            `json:"Principal,omitempty"`
+	Action    []string                       `json:"Action"`
+	Resource  []string                       `json:"Resource,omitempty"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
+}
+
+type policyDocument struct {
+	Version   string                    `json:"Version,omitempty"`
+	Statement []policyDocumentStatement `json:"Statement"`
+}
+
+func DataSourceIdentityPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1.1",
+				ValidateFunc: validation.StringInSlice([]string{"1.0", "1.1"}, false),
+			},
+			"statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"effect": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Allow",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Allow", "Deny",
+							}, false),
+						},
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resources": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"principals": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"identifiers": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+											if _, _, _, err := parseConditionOperator(v.(string)); err != nil {
+												return nil, []error{err}
+											}
+											return nil, nil
+										},
+									},
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityPolicyDocumentRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	document := policyDocument{
+		Version: d.Get("version").(string),
+	}
+
+	for _, raw := range d.Get("statement").([]interface{}) {
+		statement := raw.(map[string]interface{})
+		document.Statement = append(document.Statement, policyDocumentStatement{
+			Sid:       statement["sid"].(string),
+			Effect:    statement["effect"].(string),
+			Principal: expandPolicyDocumentPrincipals(statement["principals"].([]interface{})),
+			Action:    sortedUnique(statement["actions"].([]interface{})),
+			Resource:  sortedUnique(statement["resources"].([]interface{})),
+			Condition: expandPolicyDocumentConditions(statement["condition"].([]interface{})),
+		})
+	}
+
+	jsonDocument, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return fmterr.Errorf("error rendering policy document: %s", err)
+	}
+	// rendered document has to be valid for the policy simulation
+	if _, err := ParsePolicyDocument("", string(jsonDocument)); err != nil {
+		return fmterr.Errorf("error validating policy document: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(string(jsonDocument))))
+	if err := d.Set("json", string(jsonDocument)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func expandPolicyDocumentPrincipals(raw []interface{}) map[string][]string {
+	if len(raw) == 0 {
+		return nil
+	}
+	grouped := make(map[string][]interface{})
+	for _, p := range raw {
+		principal := p.(map[string]interface{})
+		principalType := principal["type"].(string)
+		grouped[principalType] = append(grouped[principalType], principal["identifiers"].([]interface{})...)
+	}
+	principals := make(map[string][]string, len(grouped))
+	for principalType, identifiers := range grouped {
+		principals[principalType] = sortedUnique(identifiers)
+	}
+	return principals
+}
+
+func expandPolicyDocumentConditions(raw []interface{}) map[string]map[string][]string {
+	if len(raw) == 0 {
+		return nil
+	}
+	grouped := make(map[string]map[string][]interface{})
+	for _, c := range raw {
+		condition := c.(map[string]interface{})
+		operator := condition["operator"].(string)
+		key := condition["key"].(string)
+		if grouped[operator] == nil {
+			grouped[operator] = make(map[string][]interface{})
+		}
+		grouped[operator][key] = append(grouped[operator][key], condition["values"].([]interface{})...)
+	}
+	conditions := make(map[string]map[string][]string, len(grouped))
+	for operator, keys := range grouped {
+		conditions[operator] = make(map[string][]string, len(keys))
+		for key, values := range keys {
+			conditions[operator][key] = sortedUnique(values)
+		}
+	}
+	return conditions
+}
+
+func sortedUnique(raw []interface{}) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool)
+	var result []string
+	for _, v := range common.ExpandToStringSlice(raw) {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,188 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/policies"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceIdentityPolicySimulationV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityPolicySimulationV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: common.ValidateJsonString,
+				},
+				AtLeastOneOf: []string{"policy_documents", "role_ids"},
+			},
+			"role_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"request": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"context": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityPolicySimulationV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var documents []PolicyDocument
+	for i, raw := range d.Get("policy_documents").([]interface{}) {
+		document, err := ParsePolicyDocument(fmt.Sprintf("policy_documents.%d", i), raw.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		documents = append(documents, document)
+	}
+
+	if roleIDs := d.Get("role_ids").([]interface{}); len(roleIDs) != 0 {
+		config := meta.(*cfg.Config)
+		client, err := config.IdentityV30Client()
+		if err != nil {
+			return fmterr.Errorf(clientV30CreationFail, err)
+		}
+		for _, id := range roleIDs {
+			role, err := policies.Get(client, id.(string)).Extract()
+			if err != nil {
+				return fmterr.Errorf("error fetching custom role %s: %s", id, err)
+			}
+			policyJSON, err := json.Marshal(role.Policy)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			document, err := ParsePolicyDocument(role.ID, string(policyJSON))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			documents = append(documents, document)
+		}
+	}
+
+	allAllowed := true
+	var ids []string
+	var results []map[string]interface{}
+	for _, raw := range d.Get("request").([]interface{}) {
+		request := expandSimulationRequest(raw.(map[string]interface{}))
+		result, err := SimulatePolicies(documents, request)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		allAllowed = allAllowed && result.Allowed()
+		ids = append(ids, request.Action+request.Resource+result.Decision+result.PolicyID+strconv.Itoa(result.StatementIndex))
+		results = append(results, map[string]interface{}{
+			"action":          request.Action,
+			"resource":        request.Resource,
+			"decision":        result.Decision,
+			"allowed":         result.Allowed(),
+			"policy_id":       result.PolicyID,
+			"statement_index": result.StatementIndex,
+		})
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(
+		d.Set("results", results),
+		d.Set("all_allowed", allAllowed),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting policy simulation fields: %s", err)
+	}
+	return nil
+}
+
+func expandSimulationRequest(raw map[string]interface{}) SimulationRequest {
+	request := SimulationRequest{
+		Action:   raw["action"].(string),
+		Resource: raw["resource"].(string),
+		Context:  make(map[string][]string),
+	}
+	for _, c := range raw["context"].([]interface{}) {
+		entry := c.(map[string]interface{})
+		key := entry["key"].(string)
+		request.Context[key] = append(request.Context[key], common.ExpandToStringSlice(entry["values"].([]interface{}))...)
+	}
+	return request
+}
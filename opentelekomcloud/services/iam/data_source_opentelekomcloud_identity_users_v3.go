@@ -0,0 +1,153 @@
+package iam
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3.0/security"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/users"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceIdentityUsersV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityUsersV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"password_expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mfa_device": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"login_protection_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"login_verification_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"users_without_mfa": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceIdentityUsersV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientCreationFail, err)
+	}
+
+	allPages, err := users.List(client, users.ListOpts{
+		DomainID: d.Get("domain_id").(string),
+		Name:     d.Get("name").(string),
+	}).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to query users: %s", err)
+	}
+	allUsers, err := users.ExtractUsers(allPages)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve users: %s", err)
+	}
+
+	clientV30, err := config.IdentityV30Client()
+	if err != nil {
+		return fmterr.Errorf(clientV30CreationFail, err)
+	}
+	devices, err := security.ListUserMfaDevices(clientV30)
+	if err != nil {
+		return fmterr.Errorf("error listing virtual MFA devices: %s", err)
+	}
+	mfaDevices := make(map[string]string)
+	for _, device := range devices {
+		mfaDevices[device.UserId] = device.SerialNumber
+	}
+
+	protections, err := security.ListLoginProtectionConfigurations(clientV30)
+	if err != nil {
+		return fmterr.Errorf("error listing login protection configurations: %s", err)
+	}
+	loginProtections := make(map[string]security.LoginProtectionConfig)
+	for _, protection := range protections {
+		loginProtections[protection.UserId] = protection
+	}
+
+	ids := make([]string, 0, len(allUsers))
+	withoutMfa := make([]string, 0)
+	result := make([]map[string]interface{}, 0, len(allUsers))
+	for _, user := range allUsers {
+		ids = append(ids, user.ID)
+		protection := loginProtections[user.ID]
+		protectionEnabled := protection.Enabled != nil && *protection.Enabled
+		mfaDevice := mfaDevices[user.ID]
+		if user.Enabled && mfaDevice == "" {
+			withoutMfa = append(withoutMfa, user.ID)
+		}
+		result = append(result, map[string]interface{}{
+			"id":                        user.ID,
+			"name":                      user.Name,
+			"domain_id":                 user.DomainID,
+			"enabled":                   user.Enabled,
+			"password_expires_at":       user.PasswordExpiresAt.Format(time.RFC3339),
+			"mfa_device":                mfaDevice,
+			"login_protection_enabled":  protectionEnabled,
+			"login_verification_method": protection.VerificationMethod,
+		})
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(nil,
+		d.Set("users", result),
+		d.Set("users_without_mfa", withoutMfa),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting users fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The policy simulation evaluates the custom policies offline following the IAM
+// authorization rules: an explicit Deny always wins, otherwise the request is
+// allowed if any Allow statement matches, otherwise it is denied implicitly.
+
+const (
+	policyDecisionAllow         = "allow"
+	policyDecisionExplicitDeny  = "explicit_deny"
+	policyDecisionImplicitDeny  = "implicit_deny"
+	policyEffectAllow           = "allow"
+	policyEffectDeny            = "deny"
+	policyQualifierForAnyValue  = "ForAnyValue:"
+	policyQualifierForAllValues = "ForAllValues:"
+	policySuffixIfExists        = "IfExists"
+)
+
+// PolicyStatement is a single statement of the policy document.
+type PolicyStatement struct {
+	Effect    string
+	Action    []string
+	Resource  []string
+	Condition map[string]map[string][]string
+}
+
+// PolicyDocument is a parsed policy. ID is the role ID or the index of the document.
+type PolicyDocument struct {
+	ID        string
+	Statement []PolicyStatement
+}
+
+// SimulationRequest is the action checked against the policies. Context keys are
+// case-insensitive, empty resource means resource restrictions are not checked.
+type SimulationRequest struct {
+	Action   string
+	Resource string
+	Context  map[string][]string
+}
+
+// SimulationResult is the decision for the request. PolicyID and StatementIndex
+// point to the statement deciding the request, StatementIndex is -1 for the implicit deny.
+type SimulationResult struct {
+	Decision       string
+	PolicyID       string
+	StatementIndex int
+}
+
+// Allowed reports whether the request is allowed.
+func (r SimulationResult) Allowed() bool {
+	return r.Decision == policyDecisionAllow
+}
+
+type rawPolicyStatement struct {
+	Effect    string                     `json:"Effect"`
+	Action    interface{}                `json:"Action"`
+	Resource  interface{}                `json:"Resource"`
+	Condition map[string]json.RawMessage `json:"Condition"`
+}
+
+type rawPolicyDocument struct {
+	Version   string               `json:"Version"`
+	Statement []rawPolicyStatement `json:"Statement"`
+}
+
+// ParsePolicyDocument parses the JSON policy document and validates its grammar.
+func ParsePolicyDocument(id, document string) (PolicyDocument, error) {
+	var raw rawPolicyDocument
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return PolicyDocument{}, fmt.Errorf("error parsing policy document %s: %w", id, err)
+	}
+
+	policy := PolicyDocument{ID: id}
+	for i, rawStatement := range raw.Statement {
+		statement, err := parsePolicyStatement(rawStatement)
+		if err != nil {
+			return PolicyDocument{}, fmt.Errorf("invalid statement %d of policy document %s: %w", i, id, err)
+		}
+		policy.Statement = append(policy.Statement, statement)
+	}
+	return policy, nil
+}
+
+func parsePolicyStatement(raw rawPolicyStatement) (PolicyStatement, error) {
+	effect := strings.ToLower(raw.Effect)
+	if effect != policyEffectAllow && effect != policyEffectDeny {
+		return PolicyStatement{}, fmt.Errorf("unsupported effect %q", raw.Effect)
+	}
+
+	actions, err := stringOrList(raw.Action)
+	if err != nil {
+		return PolicyStatement{}, fmt.Errorf("invalid Action: %w", err)
+	}
+	if len(actions) == 0 {
+		return PolicyStatement{}, fmt.Errorf("no actions")
+	}
+	resources, err := stringOrList(raw.Resource)
+	if err != nil {
+		return PolicyStatement{}, fmt.Errorf("invalid Resource: %w", err)
+	}
+
+	condition := make(map[string]map[string][]string, len(raw.Condition))
+	for operator, rawKeys := range raw.Condition {
+		if _, _, _, err := parseConditionOperator(operator); err != nil {
+			return PolicyStatement{}, err
+		}
+		var keys map[string]interface{}
+		if err := json.Unmarshal(rawKeys, &keys); err != nil {
+			return PolicyStatement{}, fmt.Errorf("invalid condition %s: %w", operator, err)
+		}
+		condition[operator] = make(map[string][]string, len(keys))
+		for key, rawValues := range keys {
+			values, err := stringOrList(rawValues)
+			if err != nil {
+				return PolicyStatement{}, fmt.Errorf("invalid values of condition %s/%s: %w", operator, key, err)
+			}
+			condition[operator][key] = values
+		}
+	}
+
+	return PolicyStatement{
+		Effect:    effect,
+		Action:    actions,
+		Resource:  resources,
+		Condition: condition,
+	}, nil
+}
+
+// stringOrList converts the JSON string, number, bool or list of them to the list of strings
+func stringOrList(raw interface{}) ([]string, error) {
+	switch v := raw.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case bool:
+		return []string{strconv.FormatBool(v)}, nil
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}, nil
+	case []interface{}:
+		var res []string
+		for _, item := range v {
+			values, err := stringOrList(item)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, values...)
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unexpected value %v", raw)
+	}
+}
+
+// SimulatePolicies evaluates the request against all statements of the policies.
+func SimulatePolicies(policies []PolicyDocument, request SimulationRequest) (SimulationResult, error) {
+	context := make(map[string][]string, len(request.Context))
+	for key, values := range request.Context {
+		context[strings.ToLower(key)] = values
+	}
+
+	result := SimulationResult{Decision: policyDecisionImplicitDeny, StatementIndex: -1}
+	for _, policy := range policies {
+		for i, statement := range policy.Statement {
+			matched, err := statement.matches(request, context)
+			if err != nil {
+				return SimulationResult{}, fmt.Errorf("error evaluating statement %d of policy %s: %w", i, policy.ID, err)
+			}
+			if !matched {
+				continue
+			}
+			if statement.Effect == policyEffectDeny {
+				// explicit deny can't be overridden, no need to check further
+				return SimulationResult{Decision: policyDecisionExplicitDeny, PolicyID: policy.ID, StatementIndex: i}, nil
+			}
+			if result.Decision == policyDecisionImplicitDeny {
+				result = SimulationResult{Decision: policyDecisionAllow, PolicyID: policy.ID, StatementIndex: i}
+			}
+		}
+	}
+	return result, nil
+}
+
+func (s PolicyStatement) matches(request SimulationRequest, context map[string][]string) (bool, error) {
+	if !matchAny(s.Action, request.Action, true) {
+		return false, nil
+	}
+	if request.Resource != "" && len(s.Resource) != 0 && !matchAny(s.Resource, request.Resource, false) {
+		return false, nil
+	}
+	for operator, keys := range s.Condition {
+		for key, values := range keys {
+			ok, err := evaluateCondition(operator, values, context[strings.ToLower(key)])
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func matchAny(patterns []string, value string, ignoreCase bool) bool {
+	for _, pattern := range patterns {
+		if ignoreCase {
+			if wildcardMatch(strings.ToLower(pattern), strings.ToLower(value)) {
+				return true
+			}
+		} else if wildcardMatch(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// wildcardMatch matches the value against the pattern, where `*` matches any sequence
+// of characters and `?` matches any single character
+func wildcardMatch(pattern, value string) bool {
+	p, v := 0, 0
+	star, mark := -1, 0
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, v
+			p++
+		case star != -1:
+			p = star + 1
+			mark++
+			v = mark
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+type conditionFunc func(contextValue, policyValue string) (bool, error)
+
+var conditionOperators = map[string]conditionFunc{
+	"StringEquals":            stringCondition(func(c, p string) bool { return c == p }),
+	"StringEqualsIgnoreCase":  stringCondition(strings.EqualFold),
+	"StringLike":              stringCondition(func(c, p string) bool { return wildcardMatch(p, c) }),
+	"StringStartWith":         stringCondition(strings.HasPrefix),
+	"StringEndWith":           stringCondition(strings.HasSuffix),
+	"StringEqualsAnyOf":       stringCondition(func(c, p string) bool { return c == p }),
+	"NumberEquals":            numberCondition(func(c, p float64) bool { return c == p }),
+	"NumberLessThan":          numberCondition(func(c, p float64) bool { return c < p }),
+	"NumberLessThanEquals":    numberCondition(func(c, p float64) bool { return c <= p }),
+	"NumberGreaterThan":       numberCondition(func(c, p float64) bool { return c > p }),
+	"NumberGreaterThanEquals": numberCondition(func(c, p float64) bool { return c >= p }),
+	"NumberEqualsAnyOf":       numberCondition(func(c, p float64) bool { return c == p }),
+	"DateLessThan":            dateCondition(func(c, p time.Time) bool { return c.Before(p) }),
+	"DateLessThanEquals":      dateCondition(func(c, p time.Time) bool { return !c.After(p) }),
+	"DateGreaterThan":         dateCondition(func(c, p time.Time) bool { return c.After(p) }),
+	"DateGreaterThanEquals":   dateCondition(func(c, p time.Time) bool { return !c.Before(p) }),
+	"Bool":                    stringCondition(strings.EqualFold),
+	"IpAddress":               ipAddressCondition,
+}
+
+// negatedOperators maps the negated operators to the positive ones
+var negatedOperators = map[string]string{
+	"StringNotEquals":           "StringEquals",
+	"StringNotEqualsIgnoreCase": "StringEqualsIgnoreCase",
+	"StringNotLike":             "StringLike",
+	"StringNotStartWith":        "StringStartWith",
+	"StringNotEndWith":          "StringEndWith",
+	"NumberNotEquals":           "NumberEquals",
+	"NotIpAddress":              "IpAddress",
+	"StringNotEqualsAnyOf":      "StringEqualsAnyOf",
+	"NumberNotEqualsAnyOf":      "NumberEqualsAnyOf",
+}
+
+func stringCondition(compare func(c, p string) bool) conditionFunc {
+	return func(c, p string) (bool, error) {
+		return compare(c, p), nil
+	}
+}
+
+func numberCondition(compare func(c, p float64) bool) conditionFunc {
+	return func(c, p string) (bool, error) {
+		policyValue, err := strconv.ParseFloat(p, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid number %q in condition", p)
+		}
+		contextValue, err := strconv.ParseFloat(c, 64)
+		if err != nil {
+			return false, nil
+		}
+		return compare(contextValue, policyValue), nil
+	}
+}
+
+func dateCondition(compare func(c, p time.Time) bool) conditionFunc {
+	return func(c, p string) (bool, error) {
+		policyValue, err := time.Parse(time.RFC3339, p)
+		if err != nil {
+			return false, fmt.Errorf("invalid date %q in condition", p)
+		}
+		contextValue, err := time.Parse(time.RFC3339, c)
+		if err != nil {
+			return false, nil
+		}
+		return compare(contextValue, policyValue), nil
+	}
+}
+
+func ipAddressCondition(c, p string) (bool, error) {
+	ip := net.ParseIP(c)
+	if ip == nil {
+		return false, nil
+	}
+	if !strings.Contains(p, "/") {
+		policyIP := net.ParseIP(p)
+		if policyIP == nil {
+			return false, fmt.Errorf("invalid IP address %q in condition", p)
+		}
+		return policyIP.Equal(ip), nil
+	}
+	_, network, err := net.ParseCIDR(p)
+	if err != nil {
+		return false, fmt.Errorf("invalid CIDR %q in condition", p)
+	}
+	return network.Contains(ip), nil
+}
+
+// parseConditionOperator splits the operator to the qualifier, the base operator
+// and the IfExists flag, reporting whether the base operator is negated
+func parseConditionOperator(operator string) (qualifier string, base string, ifExists bool, err error) {
+	base = operator
+	for _, q := range []string{policyQualifierForAnyValue, policyQualifierForAllValues} {
+		if strings.HasPrefix(base, q) {
+			qualifier = q
+			base = strings.TrimPrefix(base, q)
+		}
+	}
+	if base != "Null" && strings.HasSuffix(base, policySuffixIfExists) {
+		ifExists = true
+		base = strings.TrimSuffix(base, policySuffixIfExists)
+	}
+	if base == "Null" || base == "IsNullOrEmpty" {
+		return qualifier, base, ifExists, nil
+	}
+	if _, ok := negatedOperators[base]; ok {
+		return qualifier, base, ifExists, nil
+	}
+	if _, ok := conditionOperators[base]; ok {
+		return qualifier, base, ifExists, nil
+	}
+	return "", "", false, fmt.Errorf("unsupported condition operator %q", operator)
+}
+
+// evaluateCondition checks the values of the context key against the condition values.
+// Without qualifier a positive operator requires any context value to match,
+// a negated one requires no context value to match.
+func evaluateCondition(operator string, policyValues, contextValues []string) (bool, error) {
+	qualifier, base, ifExists, err := parseConditionOperator(operator)
+	if err != nil {
+		return false, err
+	}
+
+	switch base {
+	case "Null":
+		expected, err := strconv.ParseBool(firstValue(policyValues))
+		if err != nil {
+			return false, fmt.Errorf("invalid value of the Null condition: %w", err)
+		}
+		return (len(contextValues) == 0) == expected, nil
+	case "IsNullOrEmpty":
+		expected, err := strconv.ParseBool(firstValue(policyValues))
+		if err != nil {
+			return false, fmt.Errorf("invalid value of the IsNullOrEmpty condition: %w", err)
+		}
+		empty := true
+		for _, v := range contextValues {
+			if v != "" {
+				empty = false
+			}
+		}
+		return empty == expected, nil
+	}
+
+	positive, negated := negatedOperators[base]
+	if !negated {
+		positive = base
+	}
+	compare := conditionOperators[positive]
+
+	if len(contextValues) == 0 {
+		return ifExists || negated || qualifier == policyQualifierForAllValues, nil
+	}
+
+	valueMatches := func(contextValue string) (bool, error) {
+		for _, policyValue := range policyValues {
+			ok, err := compare(contextValue, policyValue)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return !negated, nil
+			}
+		}
+		return negated, nil
+	}
+
+	requireAll := qualifier == policyQualifierForAllValues || (qualifier == "" && negated)
+	for _, contextValue := range contextValues {
+		ok, err := valueMatches(contextValue)
+		if err != nil {
+			return false, err
+		}
+		if ok && !requireAll {
+			return true, nil
+		}
+		if !ok && requireAll {
+			return false, nil
+		}
+	}
+	return requireAll, nil
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
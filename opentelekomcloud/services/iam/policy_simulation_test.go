@@ -0,0 +1,269 @@
+package iam
+
+import (
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const ecsOperatorPolicy = `
+{
+  "Version": "1.1",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["ecs:*:get*", "ecs:*:list*", "ecs:servers:start", "ecs:servers:stop"]
+    },
+    {
+      "Effect": "Deny",
+      "Action": ["ecs:servers:delete"]
+    }
+  ]
+}`
+
+const obsBucketPolicy = `
+{
+  "Version": "1.1",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "obs:object:*",
+      "Resource": ["obs:*:*:object:logs/*", "obs:*:*:object:backup-??/*"]
+    }
+  ]
+}`
+
+const conditionalPolicy = `
+{
+  "Version": "1.1",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["iam:users:*"],
+      "Condition": {
+        "Bool": {"g:MFAPresent": ["true"]},
+        "IpAddress": {"g:SourceIp": ["10.0.0.0/8", "192.168.1.10"]}
+      }
+    },
+    {
+      "Effect": "Deny",
+      "Action": ["iam:users:delete*"],
+      "Condition": {
+        "StringNotEquals": {"g:UserName": ["admin", "root"]}
+      }
+    }
+  ]
+}`
+
+func mustParse(t *testing.T, id, document string) PolicyDocument {
+	policy, err := ParsePolicyDocument(id, document)
+	th.AssertNoErr(t, err)
+	return policy
+}
+
+func TestWildcardMatch(t *testing.T) {
+	cases := []struct {
+		pattern, value string
+		expected       bool
+	}{
+		{"*", "", true},
+		{"*", "ecs:servers:list", true},
+		{"ecs:*:list*", "ecs:servers:listServers", true},
+		{"ecs:*:list*", "evs:volumes:list", false},
+		{"ecs:servers:?et", "ecs:servers:get", true},
+		{"ecs:servers:?et", "ecs:servers:gett", false},
+		{"a*b*c", "aXXbYYc", true},
+		{"a*b*c", "aXXbYY", false},
+		{"", "a", false},
+	}
+	for _, c := range cases {
+		th.AssertEquals(t, c.expected, wildcardMatch(c.pattern, c.value))
+	}
+}
+
+func TestParsePolicyDocumentErrors(t *testing.T) {
+	_, err := ParsePolicyDocument("bad-json", `{"Statement": [`)
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = ParsePolicyDocument("bad-effect", `{"Statement": [{"Effect": "Maybe", "Action": ["*:*:*"]}]}`)
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = ParsePolicyDocument("no-action", `{"Statement": [{"Effect": "Allow"}]}`)
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = ParsePolicyDocument("bad-operator", `{"Statement": [{"Effect": "Allow", "Action": ["*:*:*"],
+		"Condition": {"StringSoundsLike": {"g:UserName": ["bob"]}}}]}`)
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestSimulateActionWildcards(t *testing.T) {
+	policies := []PolicyDocument{mustParse(t, "ecs-operator", ecsOperatorPolicy)}
+
+	res, err := SimulatePolicies(policies, SimulationRequest{Action: "ecs:servers:list"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, policyDecisionAllow, res.Decision)
+	th.AssertEquals(t, "ecs-operator", res.PolicyID)
+	th.AssertEquals(t, 0, res.StatementIndex)
+
+	// action matching is case-insensitive
+	res, err = SimulatePolicies(policies, SimulationRequest{Action: "ECS:Servers:Get"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, res.Allowed())
+
+	res, err = SimulatePolicies(policies, SimulationRequest{Action: "ecs:servers:create"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, policyDecisionImplicitDeny, res.Decision)
+	th.AssertEquals(t, -1, res.StatementIndex)
+}
+
+func TestSimulateDenyPrecedence(t *testing.T) {
+	allowAll := mustParse(t, "admin", `{"Version": "1.1", "Statement": [{"Effect": "Allow", "Action": ["*:*:*"]}]}`)
+	policies := []PolicyDocument{allowAll, mustParse(t, "ecs-operator", ecsOperatorPolicy)}
+
+	res, err := SimulatePolicies(policies, SimulationRequest{Action: "ecs:servers:delete"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, policyDecisionExplicitDeny, res.Decision)
+	th.AssertEquals(t, "ecs-operator", res.PolicyID)
+	th.AssertEquals(t, 1, res.StatementIndex)
+
+	// the first matching allow statement is reported
+	res, err = SimulatePolicies(policies, SimulationRequest{Action: "ecs:servers:list"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, policyDecisionAllow, res.Decision)
+	th.AssertEquals(t, "admin", res.PolicyID)
+}
+
+func TestSimulateResources(t *testing.T) {
+	policies := []PolicyDocument{mustParse(t, "obs", obsBucketPolicy)}
+
+	res, err := SimulatePolicies(policies, SimulationRequest{
+		Action:   "obs:object:GetObject",
+		Resource: "obs:eu-de:domain:object:logs/2024/app.log",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, res.Allowed())
+
+	res, err = SimulatePolicies(policies, SimulationRequest{
+		Action:   "obs:object:GetObject",
+		Resource: "obs:eu-de:domain:object:backup-01/db.dump",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, res.Allowed())
+
+	res, err = SimulatePolicies(policies, SimulationRequest{
+		Action:   "obs:object:GetObject",
+		Resource: "obs:eu-de:domain:object:backup-001/db.dump",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, policyDecisionImplicitDeny, res.Decision)
+
+	// resource restrictions are not checked without resource
+	res, err = SimulatePolicies(policies, SimulationRequest{Action: "obs:object:PutObject"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, res.Allowed())
+}
+
+func TestSimulateConditions(t *testing.T) {
+	policies := []PolicyDocument{mustParse(t, "conditional", conditionalPolicy)}
+
+	res, err := SimulatePolicies(policies, SimulationRequest{
+		Action: "iam:users:list",
+		Context: map[string][]string{
+			"g:MFAPresent": {"True"},
+			"g:SourceIp":   {"10.1.2.3"},
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, res.Allowed())
+
+	// context keys are case-insensitive, all condition operators must be satisfied
+	res, err = SimulatePolicies(policies, SimulationRequest{
+		Action: "iam:users:list",
+		Context: map[string][]string{
+			"G:MFAPRESENT": {"true"},
+			"g:sourceip":   {"172.16.0.1"},
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, policyDecisionImplicitDeny, res.Decision)
+
+	// missing key doesn't satisfy positive operator
+	res, err = SimulatePolicies(policies, SimulationRequest{
+		Action:  "iam:users:list",
+		Context: map[string][]string{"g:SourceIp": {"192.168.1.10"}},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, policyDecisionImplicitDeny, res.Decision)
+
+	fullContext := map[string][]string{
+		"g:MFAPresent": {"true"},
+		"g:SourceIp":   {"192.168.1.10"},
+	}
+	res, err = SimulatePolicies(policies, SimulationRequest{Action: "iam:users:deleteUser", Context: fullContext})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, policyDecisionExplicitDeny, res.Decision)
+
+	fullContext["g:UserName"] = []string{"admin"}
+	res, err = SimulatePolicies(policies, SimulationRequest{Action: "iam:users:deleteUser", Context: fullContext})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, policyDecisionAllow, res.Decision)
+}
+
+func TestEvaluateConditionOperators(t *testing.T) {
+	cases := []struct {
+		name     string
+		operator string
+		policy   []string
+		context  []string
+		expected bool
+	}{
+		{"equals", "StringEquals", []string{"a", "b"}, []string{"b"}, true},
+		{"equals case", "StringEquals", []string{"a"}, []string{"A"}, false},
+		{"equals ignore case", "StringEqualsIgnoreCase", []string{"a"}, []string{"A"}, true},
+		{"not equals", "StringNotEquals", []string{"a"}, []string{"b"}, true},
+		{"not equals matched", "StringNotEquals", []string{"a"}, []string{"a"}, false},
+		{"not equals missing", "StringNotEquals", []string{"a"}, nil, true},
+		{"like", "StringLike", []string{"dev-*"}, []string{"dev-team"}, true},
+		{"not like", "StringNotLike", []string{"dev-*"}, []string{"dev-team"}, false},
+		{"start with", "StringStartWith", []string{"eu-"}, []string{"eu-de"}, true},
+		{"end with", "StringEndWith", []string{".com"}, []string{"example.org"}, false},
+		{"number less", "NumberLessThan", []string{"10"}, []string{"9.5"}, true},
+		{"number greater equals", "NumberGreaterThanEquals", []string{"10"}, []string{"10"}, true},
+		{"number not a number", "NumberEquals", []string{"10"}, []string{"ten"}, false},
+		{"date before", "DateLessThan", []string{"2030-01-01T00:00:00Z"}, []string{"2024-06-01T12:00:00Z"}, true},
+		{"date after", "DateGreaterThan", []string{"2030-01-01T00:00:00Z"}, []string{"2024-06-01T12:00:00Z"}, false},
+		{"ip cidr", "IpAddress", []string{"10.0.0.0/8"}, []string{"10.20.30.40"}, true},
+		{"not ip", "NotIpAddress", []string{"10.0.0.0/8"}, []string{"10.20.30.40"}, false},
+		{"missing key", "StringEquals", []string{"a"}, nil, false},
+		{"if exists missing", "StringEqualsIfExists", []string{"a"}, nil, true},
+		{"if exists present", "StringEqualsIfExists", []string{"a"}, []string{"b"}, false},
+		{"null missing", "Null", []string{"true"}, nil, true},
+		{"null present", "Null", []string{"true"}, []string{"x"}, false},
+		{"null or empty", "IsNullOrEmpty", []string{"true"}, []string{""}, true},
+		{"any value", "ForAnyValue:StringEquals", []string{"a"}, []string{"x", "a"}, true},
+		{"all values", "ForAllValues:StringEquals", []string{"a", "b"}, []string{"a", "b"}, true},
+		{"all values partial", "ForAllValues:StringEquals", []string{"a"}, []string{"a", "b"}, false},
+		{"all values missing", "ForAllValues:StringEquals", []string{"a"}, nil, true},
+		{"not equals multi", "StringNotEquals", []string{"a"}, []string{"b", "a"}, false},
+		{"any value not equals", "ForAnyValue:StringNotEquals", []string{"a"}, []string{"b", "a"}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := evaluateCondition(c.operator, c.policy, c.context)
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, c.expected, res)
+		})
+	}
+}
+
+func TestEvaluateConditionInvalidValues(t *testing.T) {
+	_, err := evaluateCondition("NumberEquals", []string{"ten"}, []string{"10"})
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = evaluateCondition("IpAddress", []string{"10.0.0.0/33"}, []string{"10.0.0.1"})
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = evaluateCondition("Null", []string{"maybe"}, nil)
+	th.AssertEquals(t, true, err != nil)
+}
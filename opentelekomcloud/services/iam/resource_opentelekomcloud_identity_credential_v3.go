@@ -2,10 +2,14 @@ package iam
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/credentials"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -22,6 +26,9 @@ func ResourceIdentityCredentialV3() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: resourceIdentityCredentialV3RotationDiff,
+
 		Schema: map[string]*schema.Schema{
 			"user_id": {
 				Type:     schema.TypeString,
@@ -65,6 +72,57 @@ func ResourceIdentityCredentialV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"rotation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_age_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"overlap_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"current":  credentialKeySchema(),
+			"previous": credentialKeySchema(),
+		},
+	}
+}
+
+func credentialKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+				"secret": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"create_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
 		},
 	}
 }
@@ -86,41 +144,62 @@ func resourceIdentityCredentialV3Create(ctx context.Context, d *schema.ResourceD
 			"`user_id` or authenticate with token auth (not using AK/SK)")
 	}
 
+	credential, err := createCredential(client, d, userID.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.AccessKey)
+
+	if err := d.Set("previous", nil); err != nil {
+		return fmterr.Errorf("error setting identity previous key field: %s", err)
+	}
+
+	return resourceIdentityCredentialV3Read(ctx, d, meta)
+}
+
+// createCredential creates new AK/SK and stores its (optionally encrypted) secret
+// both in `secret` and `current` fields
+func createCredential(client *golangsdk.ServiceClient, d *schema.ResourceData, userID string) (*credentials.Credential, error) {
 	credential, err := credentials.Create(client, credentials.CreateOpts{
-		UserID:      userID.(string),
+		UserID:      userID,
 		Description: d.Get("description").(string),
 	}).Extract()
 	if err != nil {
-		return fmterr.Errorf("error creating AK/SK: %s", err)
+		return nil, fmt.Errorf("error creating AK/SK: %s", err)
 	}
 
-	d.SetId(credential.AccessKey)
-
+	secret := credential.SecretKey
 	if v, ok := d.GetOk("pgp_key"); ok {
-		pgpKey := v.(string)
-		encryptionKey, err := encryption.RetrieveGPGKey(pgpKey)
+		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
 		if err != nil {
-			return fmterr.Errorf("Error retrieving PGP key: %s", err)
+			return nil, fmt.Errorf("Error retrieving PGP key: %s", err)
 		}
 		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, credential.SecretKey, "IAM Access Key Secret")
 		if err != nil {
-			return fmterr.Errorf("Error encrypting access key: %s", err)
+			return nil, fmt.Errorf("Error encrypting access key: %s", err)
 		}
-
-		mErr := multierror.Append(nil,
-			d.Set("key_fingerprint", fingerprint),
-			d.Set("secret", encrypted),
-		)
-		if err = mErr.ErrorOrNil(); err != nil {
-			return fmterr.Errorf("error setting identity access key fields: %s", err)
-		}
-	} else {
-		if err := d.Set("secret", credential.SecretKey); err != nil {
-			return fmterr.Errorf("error setting identity secret key field: %w", err)
+		if err := d.Set("key_fingerprint", fingerprint); err != nil {
+			return nil, fmt.Errorf("error setting identity key fingerprint field: %s", err)
 		}
+		secret = encrypted
 	}
 
-	return resourceIdentityCredentialV3Read(ctx, d, meta)
+	mErr := multierror.Append(nil,
+		d.Set("secret", secret),
+		d.Set("current", []map[string]interface{}{
+			{
+				"access":      credential.AccessKey,
+				"secret":      secret,
+				"status":      string(credential.Status),
+				"create_time": credential.CreateTime,
+			},
+		}),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, fmt.Errorf("error setting identity access key fields: %s", err)
+	}
+	return credential, nil
 }
 
 func resourceIdentityCredentialV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -133,6 +212,11 @@ func resourceIdentityCredentialV3Read(_ context.Context, d *schema.ResourceData,
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "IAM credentials")
 	}
+	// `current` is empty for the keys created before rotation support
+	secret := d.Get("current.0.secret").(string)
+	if secret == "" {
+		secret = d.Get("secret").(string)
+	}
 	mErr := multierror.Append(nil,
 		d.Set("user_id", credential.UserID),
 		d.Set("access", credential.AccessKey),
@@ -140,7 +224,35 @@ func resourceIdentityCredentialV3Read(_ context.Context, d *schema.ResourceData,
 		d.Set("create_time", credential.CreateTime),
 		d.Set("last_use_time", credential.LastUseTime),
 		d.Set("description", credential.Description),
+		d.Set("current", []map[string]interface{}{
+			{
+				"access":      credential.AccessKey,
+				"secret":      secret,
+				"status":      string(credential.Status),
+				"create_time": credential.CreateTime,
+			},
+		}),
 	)
+
+	if previousID := d.Get("previous.0.access").(string); previousID != "" {
+		previous, err := credentials.Get(client, previousID).Extract()
+		switch {
+		case common.IsResourceNotFound(err):
+			mErr = multierror.Append(mErr, d.Set("previous", nil))
+		case err != nil:
+			return fmterr.Errorf("error reading previous AK/SK: %s", err)
+		default:
+			mErr = multierror.Append(mErr, d.Set("previous", []map[string]interface{}{
+				{
+					"access":      previous.AccessKey,
+					"secret":      d.Get("previous.0.secret"),
+					"status":      string(previous.Status),
+					"create_time": previous.CreateTime,
+				},
+			}))
+		}
+	}
+
 	if err := mErr.ErrorOrNil(); err != nil {
 		return fmterr.Errorf("error setting AK/SK attributes: %s", err)
 	}
@@ -153,17 +265,57 @@ func resourceIdentityCredentialV3Update(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return fmterr.Errorf("error creating OpenStack identity client: %s", err)
 	}
-	opts := credentials.UpdateOpts{}
-	if d.HasChange("status") {
-		opts.Status = d.Get("status").(string)
+
+	rotate, expired, err := credentialRotationState(d, time.Now())
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if d.HasChange("description") {
-		opts.Description = d.Get("description").(string)
+
+	if d.HasChanges("status", "description") {
+		opts := credentials.UpdateOpts{}
+		if d.HasChange("status") {
+			opts.Status = d.Get("status").(string)
+		}
+		if d.HasChange("description") {
+			opts.Description = d.Get("description").(string)
+		}
+		_, err = credentials.Update(client, d.Id(), opts).Extract()
+		if err != nil {
+			return fmterr.Errorf("error updating AK/SK: %s", err)
+		}
 	}
-	_, err = credentials.Update(client, d.Id(), opts).Extract()
-	if err != nil {
-		return fmterr.Errorf("error updating AK/SK: %s", err)
+
+	switch {
+	case rotate:
+		// IAM user can have only 2 access keys, so the key from the previous rotation goes first
+		if err := deleteCredential(client, d.Get("previous.0.access").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+		previous := d.Get("current").([]interface{})
+		credential, err := createCredential(client, d, d.Get("user_id").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(credential.AccessKey)
+		if err := d.Set("previous", previous); err != nil {
+			return fmterr.Errorf("error setting identity previous key field: %s", err)
+		}
+		// new key is always created active
+		if status := d.Get("status").(string); status != "" && status != string(credential.Status) {
+			_, err = credentials.Update(client, d.Id(), credentials.UpdateOpts{Status: status}).Extract()
+			if err != nil {
+				return fmterr.Errorf("error updating AK/SK status: %s", err)
+			}
+		}
+	case expired:
+		if err := deleteCredential(client, d.Get("previous.0.access").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("previous", nil); err != nil {
+			return fmterr.Errorf("error setting identity previous key field: %s", err)
+		}
 	}
+
 	return resourceIdentityCredentialV3Read(ctx, d, meta)
 }
 
@@ -173,6 +325,9 @@ func resourceIdentityCredentialV3Delete(_ context.Context, d *schema.ResourceDat
 	if err != nil {
 		return fmterr.Errorf("error creating OpenStack identity client: %s", err)
 	}
+	if err := deleteCredential(client, d.Get("previous.0.access").(string)); err != nil {
+		return diag.FromErr(err)
+	}
 	err = credentials.Delete(client, d.Id()).ExtractErr()
 	if err != nil {
 		return fmterr.Errorf("error deleting AK/SK: %s", err)
@@ -180,3 +335,78 @@ func resourceIdentityCredentialV3Delete(_ context.Context, d *schema.ResourceDat
 	d.SetId("")
 	return nil
 }
+
+// deleteCredential deactivates and deletes rotated AK/SK, if any
+func deleteCredential(client *golangsdk.ServiceClient, id string) error {
+	if id == "" {
+		return nil
+	}
+	_, err := credentials.Update(client, id, credentials.UpdateOpts{Status: "inactive"}).Extract()
+	if err != nil {
+		if common.IsResourceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error deactivating previous AK/SK: %s", err)
+	}
+	err = credentials.Delete(client, id).ExtractErr()
+	if err != nil && !common.IsResourceNotFound(err) {
+		return fmt.Errorf("error deleting previous AK/SK: %s", err)
+	}
+	return nil
+}
+
+type credentialState interface {
+	Get(string) interface{}
+}
+
+// credentialRotationState returns whether the current key has to be rotated
+// and whether the previous key has to be removed
+func credentialRotationState(d credentialState, now time.Time) (bool, bool, error) {
+	hasPrevious := d.Get("previous.0.access").(string) != ""
+	if len(d.Get("rotation").([]interface{})) == 0 {
+		return false, hasPrevious, nil
+	}
+	maxAge := time.Duration(d.Get("rotation.0.max_age_days").(int)) * 24 * time.Hour
+	overlap := time.Duration(d.Get("rotation.0.overlap_days").(int)) * 24 * time.Hour
+	if overlap >= maxAge {
+		return false, false, fmt.Errorf("rotation overlap_days has to be less than max_age_days")
+	}
+
+	createTime := d.Get("current.0.create_time").(string)
+	if createTime == "" {
+		return false, false, nil
+	}
+	created, err := parseCredentialTime(createTime)
+	if err != nil {
+		return false, false, err
+	}
+	return now.Sub(created) >= maxAge, hasPrevious && now.Sub(created) >= overlap, nil
+}
+
+func parseCredentialTime(value string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02 15:04:05.999999", "2006-01-02T15:04:05.999999Z", time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("error parsing AK/SK creation time %q", value)
+}
+
+func resourceIdentityCredentialV3RotationDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rotate, expired, err := credentialRotationState(d, time.Now())
+	if err != nil {
+		return err
+	}
+	if rotate {
+		for _, key := range []string{"access", "secret", "create_time", "last_use_time", "current", "previous"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if expired {
+		return d.SetNewComputed("previous")
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package iam
+
+import (
+	"testing"
+	"time"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+// testCredentialState is the flat-key state as it is returned by `ResourceData.Get`
+type testCredentialState map[string]interface{}
+
+func (s testCredentialState) Get(key string) interface{} {
+	if v, ok := s[key]; ok {
+		return v
+	}
+	switch key {
+	case "rotation":
+		return []interface{}{}
+	case "rotation.0.max_age_days", "rotation.0.overlap_days":
+		return 0
+	}
+	return ""
+}
+
+func testRotatedCredential(maxAge, overlap int, previous string) testCredentialState {
+	return testCredentialState{
+		"rotation":                []interface{}{map[string]interface{}{}},
+		"rotation.0.max_age_days": maxAge,
+		"rotation.0.overlap_days": overlap,
+		"current.0.create_time":   "2024-01-01 00:00:00.000000",
+		"previous.0.access":       previous,
+	}
+}
+
+func TestCredentialRotationState(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	cases := []struct {
+		name    string
+		state   testCredentialState
+		now     time.Time
+		rotate  bool
+		expired bool
+	}{
+		{
+			name:  "not due",
+			state: testRotatedCredential(30, 7, ""),
+			now:   created.Add(10 * day),
+		},
+		{
+			name:   "due",
+			state:  testRotatedCredential(30, 7, ""),
+			now:    created.Add(30 * day),
+			rotate: true,
+		},
+		{
+			name:    "due with previous key",
+			state:   testRotatedCredential(30, 7, "previous-ak"),
+			now:     created.Add(31 * day),
+			rotate:  true,
+			expired: true,
+		},
+		{
+			name:  "inside overlap window",
+			state: testRotatedCredential(30, 7, "previous-ak"),
+			now:   created.Add(3 * day),
+		},
+		{
+			name:    "overlap expired",
+			state:   testRotatedCredential(30, 7, "previous-ak"),
+			now:     created.Add(7 * day),
+			expired: true,
+		},
+		{
+			name:    "rotation removed",
+			state:   testCredentialState{"previous.0.access": "previous-ak"},
+			now:     created,
+			expired: true,
+		},
+		{
+			name: "not created yet",
+			state: testCredentialState{
+				"rotation":                []interface{}{map[string]interface{}{}},
+				"rotation.0.max_age_days": 30,
+			},
+			now: created.Add(100 * day),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rotate, expired, err := credentialRotationState(c.state, c.now)
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, c.rotate, rotate)
+			th.AssertEquals(t, c.expired, expired)
+		})
+	}
+}
+
+func TestCredentialRotationStateErrors(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, _, err := credentialRotationState(testRotatedCredential(7, 7, ""), now)
+	if err == nil {
+		t.Fatal("expected error for overlap_days equal to max_age_days")
+	}
+
+	state := testRotatedCredential(30, 7, "")
+	state["current.0.create_time"] = "yesterday"
+	_, _, err = credentialRotationState(state, now)
+	if err == nil {
+		t.Fatal("expected error for invalid creation time")
+	}
+}
+
+func TestParseCredentialTime(t *testing.T) {
+	expected := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, value := range []string{
+		"2024-01-02 03:04:05.000000",
+		"2024-01-02T03:04:05.000000Z",
+		"2024-01-02T03:04:05Z",
+	} {
+		parsed, err := parseCredentialTime(value)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, true, expected.Equal(parsed))
+	}
+}
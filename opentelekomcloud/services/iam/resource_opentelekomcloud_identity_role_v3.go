@@ -73,9 +73,10 @@ func ResourceIdentityRoleV3() *schema.Resource {
 							},
 						},
 						"condition": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: common.ValidateJsonString,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     common.ValidateJsonString,
+							DiffSuppressFunc: common.SuppressEquivalentPolicyDiffs,
 							StateFunc: func(v interface{}) string {
 								jsonString, _ := common.NormalizeJsonString(v)
 								return jsonString
@@ -0,0 +1,183 @@
+package iam
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3.0/security"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/users"
+	"github.com/skip2/go-qrcode"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/encryption"
+)
+
+const mfaIssuer = "OpenTelekomCloud"
+
+func ResourceIdentityVirtualMfaDeviceV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityVirtualMfaDeviceV3Create,
+		ReadContext:   resourceIdentityVirtualMfaDeviceV3Read,
+		DeleteContext: resourceIdentityVirtualMfaDeviceV3Delete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base32_string_seed": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"qr_code_png": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityVirtualMfaDeviceV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV30, func() (*golangsdk.ServiceClient, error) {
+		return config.IdentityV30Client()
+	})
+	if err != nil {
+		return fmterr.Errorf(clientV30CreationFail, err)
+	}
+
+	name := d.Get("name").(string)
+	device, err := users.CreateMfaDevice(client, users.CreateMfaDeviceOpts{
+		Name:   name,
+		UserId: d.Get("user_id").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating virtual MFA device: %s", err)
+	}
+	d.SetId(device.SerialNumber)
+
+	// API returns only the seed, QR code has to be generated from the otpauth URI
+	uri := fmt.Sprintf("otpauth://totp/%s:%s?secret=%s&issuer=%s",
+		url.PathEscape(mfaIssuer), url.PathEscape(name), device.Base32StringSeed, url.QueryEscape(mfaIssuer))
+	png, err := qrcode.Encode(uri, qrcode.Medium, 256)
+	if err != nil {
+		return fmterr.Errorf("error generating virtual MFA device QR code: %s", err)
+	}
+
+	seed := device.Base32StringSeed
+	qrCode := base64.StdEncoding.EncodeToString(png)
+	if v, ok := d.GetOk("pgp_key"); ok {
+		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
+		if err != nil {
+			return fmterr.Errorf("error retrieving PGP key: %s", err)
+		}
+		fingerprint, encryptedSeed, err := encryption.EncryptValue(encryptionKey, seed, "virtual MFA device seed")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, encryptedQrCode, err := encryption.EncryptValue(encryptionKey, string(png), "virtual MFA device QR code")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("key_fingerprint", fingerprint); err != nil {
+			return fmterr.Errorf("error setting virtual MFA device key fingerprint: %s", err)
+		}
+		seed = encryptedSeed
+		qrCode = encryptedQrCode
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("base32_string_seed", seed),
+		d.Set("qr_code_png", qrCode),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting virtual MFA device fields: %s", err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV30)
+	return resourceIdentityVirtualMfaDeviceV3Read(clientCtx, d, meta)
+}
+
+func resourceIdentityVirtualMfaDeviceV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV30, func() (*golangsdk.ServiceClient, error) {
+		return config.IdentityV30Client()
+	})
+	if err != nil {
+		return fmterr.Errorf(clientV30CreationFail, err)
+	}
+
+	devices, err := security.ListUserMfaDevices(client)
+	if err != nil {
+		return fmterr.Errorf("error listing virtual MFA devices: %s", err)
+	}
+
+	for _, device := range devices {
+		if device.SerialNumber != d.Id() {
+			continue
+		}
+		mErr := multierror.Append(nil,
+			d.Set("serial_number", device.SerialNumber),
+			d.Set("user_id", device.UserId),
+		)
+		if err := mErr.ErrorOrNil(); err != nil {
+			return fmterr.Errorf("error setting virtual MFA device fields: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Virtual MFA device %s not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceIdentityVirtualMfaDeviceV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV30, func() (*golangsdk.ServiceClient, error) {
+		return config.IdentityV30Client()
+	})
+	if err != nil {
+		return fmterr.Errorf(clientV30CreationFail, err)
+	}
+
+	err = users.DeleteMfaDevice(client, users.DeleteMfaDeviceOpts{
+		UserId:       d.Get("user_id").(string),
+		SerialNumber: d.Id(),
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "virtual MFA device")
+	}
+	return nil
+}
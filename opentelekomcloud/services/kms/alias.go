@@ -0,0 +1,104 @@
+package kms
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Key aliases are not covered by gophertelekomcloud yet.
+
+type CreateAliasOpts struct {
+	KeyID string `json:"key_id" required:"true"`
+	Alias string `json:"alias" required:"true"`
+}
+
+type AssociateAliasOpts struct {
+	Alias       string `json:"alias" required:"true"`
+	TargetKeyID string `json:"target_key_id" required:"true"`
+}
+
+type DeleteAliasOpts struct {
+	KeyID   string   `json:"key_id" required:"true"`
+	Aliases []string `json:"aliases" required:"true"`
+}
+
+type ListAliasesOpts struct {
+	KeyID  string `q:"key_id"`
+	Limit  int    `q:"limit"`
+	Marker string `q:"marker"`
+}
+
+type Alias struct {
+	DomainID   string `json:"domain_id"`
+	KeyID      string `json:"key_id"`
+	Alias      string `json:"alias"`
+	AliasUrn   string `json:"alias_urn"`
+	CreateTime string `json:"create_time"`
+	UpdateTime string `json:"update_time"`
+}
+
+func CreateAlias(client *golangsdk.ServiceClient, opts CreateAliasOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// POST /v1.0/{project_id}/kms/aliases
+	_, err = client.Post(client.ServiceURL("kms", "aliases"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func AssociateAlias(client *golangsdk.ServiceClient, opts AssociateAliasOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v1.0/{project_id}/kms/aliases/associate
+	_, err = client.Put(client.ServiceURL("kms", "aliases", "associate"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeleteAlias(client *golangsdk.ServiceClient, opts DeleteAliasOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// DELETE /v1.0/{project_id}/kms/aliases
+	_, err = client.DeleteWithBody(client.ServiceURL("kms", "aliases"), b, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func ListAliases(client *golangsdk.ServiceClient, opts ListAliasesOpts) ([]Alias, error) {
+	var aliases []Alias
+	for {
+		q, err := golangsdk.BuildQueryString(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v1.0/{project_id}/kms/aliases
+		var res struct {
+			Aliases  []Alias `json:"aliases"`
+			PageInfo struct {
+				NextMarker string `json:"next_marker"`
+				Truncated  bool   `json:"truncated"`
+			} `json:"page_info"`
+		}
+		_, err = client.Get(client.ServiceURL("kms", "aliases")+q.String(), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, res.Aliases...)
+		if !res.PageInfo.Truncated || res.PageInfo.NextMarker == "" {
+			return aliases, nil
+		}
+		opts.Marker = res.PageInfo.NextMarker
+	}
+}
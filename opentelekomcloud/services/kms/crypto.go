@@ -0,0 +1,199 @@
+package kms
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
+)
+
+// gophertelekomcloud doesn't support encryption context for data encryption yet.
+
+const (
+	maxPlainTextLength         = 4096
+	maxEncryptionContextLength = 8192
+	minCipherTextLength        = 188
+	maxCipherTextLength        = 5648
+)
+
+var (
+	keyIDRegexp      = regexp.MustCompile(`^[0-9a-z]{8}-[0-9a-z]{4}-[0-9a-z]{4}-[0-9a-z]{4}-[0-9a-z]{12}$`)
+	cipherTextRegexp = regexp.MustCompile(`^[0-9a-zA-Z+/=]+$`)
+)
+
+type EncryptDataOpts struct {
+	KeyID             string            `json:"key_id" required:"true"`
+	PlainText         string            `json:"plain_text" required:"true"`
+	EncryptionContext map[string]string `json:"encryption_context,omitempty"`
+}
+
+type DecryptDataOpts struct {
+	CipherText        string            `json:"cipher_text" required:"true"`
+	EncryptionContext map[string]string `json:"encryption_context,omitempty"`
+}
+
+type EncryptDataResp struct {
+	KeyID      string `json:"key_id"`
+	CipherText string `json:"cipher_text"`
+}
+
+type DecryptDataResp struct {
+	PlainText string `json:"plain_text"`
+}
+
+func EncryptData(client *golangsdk.ServiceClient, opts EncryptDataOpts) (*EncryptDataResp, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v1.0/{project_id}/kms/encrypt-data
+	var res EncryptDataResp
+	_, err = client.Post(client.ServiceURL("kms", "encrypt-data"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func DecryptData(client *golangsdk.ServiceClient, opts DecryptDataOpts) (*DecryptDataResp, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v1.0/{project_id}/kms/decrypt-data
+	var res DecryptDataResp
+	_, err = client.Post(client.ServiceURL("kms", "decrypt-data"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func expandEncryptionContext(raw map[string]interface{}) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	encryptionContext := make(map[string]string, len(raw))
+	for k, v := range raw {
+		encryptionContext[k] = v.(string)
+	}
+	serialized, err := json.Marshal(encryptionContext)
+	if err != nil {
+		return nil, err
+	}
+	if len(serialized) > maxEncryptionContextLength {
+		return nil, fmt.Errorf("encryption context can't be longer than %d characters, got %d",
+			maxEncryptionContextLength, len(serialized))
+	}
+	return encryptionContext, nil
+}
+
+// Asymmetric keys are not covered by gophertelekomcloud yet.
+
+type CreateKeyOpts struct {
+	KeyAlias       string `json:"key_alias" required:"true"`
+	KeyDescription string `json:"key_description,omitempty"`
+	Realm          string `json:"realm,omitempty"`
+	KeySpec        string `json:"key_spec,omitempty"`
+	KeyUsage       string `json:"key_usage,omitempty"`
+	Origin         string `json:"origin,omitempty"`
+}
+
+// KeySpecification is the describe-key response with the specification fields, which keys.Key doesn't have.
+type KeySpecification struct {
+	keys.Key
+	KeySpec  string `json:"key_spec"`
+	KeyUsage string `json:"key_usage"`
+}
+
+type SignOpts struct {
+	KeyID            string `json:"key_id" required:"true"`
+	Message          string `json:"message" required:"true"`
+	SigningAlgorithm string `json:"signing_algorithm" required:"true"`
+	MessageType      string `json:"message_type,omitempty"`
+}
+
+type SignResp struct {
+	KeyID     string `json:"key_id"`
+	Signature string `json:"signature"`
+}
+
+func CreateKey(client *golangsdk.ServiceClient, opts CreateKeyOpts) (*keys.Key, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v1.0/{project_id}/kms/create-key
+	var res struct {
+		KeyInfo keys.Key `json:"key_info"`
+	}
+	_, err = client.Post(client.ServiceURL("kms", "create-key"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.KeyInfo, nil
+}
+
+func GetKeySpecification(client *golangsdk.ServiceClient, keyID string) (*KeySpecification, error) {
+	b := map[string]interface{}{
+		"key_id": keyID,
+	}
+
+	// POST /v1.0/{project_id}/kms/describe-key
+	var res struct {
+		KeyInfo KeySpecification `json:"key_info"`
+	}
+	_, err := client.Post(client.ServiceURL("kms", "describe-key"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.KeyInfo, nil
+}
+
+func GetPublicKey(client *golangsdk.ServiceClient, keyID string) (string, error) {
+	b := map[string]interface{}{
+		"key_id": keyID,
+	}
+
+	// POST /v1.0/{project_id}/kms/get-publickey
+	var res struct {
+		PublicKey string `json:"public_key"`
+	}
+	_, err := client.Post(client.ServiceURL("kms", "get-publickey"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.PublicKey, nil
+}
+
+func Sign(client *golangsdk.ServiceClient, opts SignOpts) (*SignResp, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v1.0/{project_id}/kms/sign
+	var res SignResp
+	_, err = client.Post(client.ServiceURL("kms", "sign"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
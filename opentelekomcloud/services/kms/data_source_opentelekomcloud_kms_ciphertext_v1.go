@@ -0,0 +1,81 @@
+package kms
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceKmsCiphertextV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKmsCiphertextV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(keyIDRegexp,
+					"key ID has to be in UUID format"),
+			},
+			"plain_text": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, maxPlainTextLength),
+			},
+			"encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cipher_text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsCiphertextV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	encryptionContext, err := expandEncryptionContext(d.Get("encryption_context").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	encrypted, err := EncryptData(client, EncryptDataOpts{
+		KeyID:             keyID,
+		PlainText:         d.Get("plain_text").(string),
+		EncryptionContext: encryptionContext,
+	})
+	if err != nil {
+		return fmterr.Errorf("error encrypting data with KMS key %s: %s", keyID, err)
+	}
+
+	d.SetId(hashcode.Strings([]string{keyID, encrypted.CipherText}))
+	mErr := multierror.Append(nil,
+		d.Set("cipher_text", encrypted.CipherText),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting KMS ciphertext fields: %s", err)
+	}
+	return nil
+}
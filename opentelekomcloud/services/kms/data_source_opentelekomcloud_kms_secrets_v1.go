@@ -0,0 +1,100 @@
+package kms
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceKmsSecretsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKmsSecretsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"secret": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"cipher_text": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(minCipherTextLength, maxCipherTextLength),
+								validation.StringMatch(cipherTextRegexp, "cipher text has to be base64 encoded string"),
+							),
+						},
+						"encryption_context": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"plaintext": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsSecretsV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	var names []string
+	plaintext := make(map[string]string)
+	for _, raw := range d.Get("secret").(*schema.Set).List() {
+		secret := raw.(map[string]interface{})
+		name := secret["name"].(string)
+		if _, ok := plaintext[name]; ok {
+			return fmterr.Errorf("secret name %q is used more than once", name)
+		}
+
+		encryptionContext, err := expandEncryptionContext(secret["encryption_context"].(map[string]interface{}))
+		if err != nil {
+			return fmterr.Errorf("invalid encryption context of secret %q: %s", name, err)
+		}
+		decrypted, err := DecryptData(client, DecryptDataOpts{
+			CipherText:        secret["cipher_text"].(string),
+			EncryptionContext: encryptionContext,
+		})
+		if err != nil {
+			return fmterr.Errorf("error decrypting secret %q: %s", name, err)
+		}
+		names = append(names, name)
+		plaintext[name] = decrypted.PlainText
+	}
+
+	d.SetId(hashcode.Strings(names))
+	mErr := multierror.Append(nil,
+		d.Set("plaintext", plaintext),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting KMS secrets fields: %s", err)
+	}
+	return nil
+}
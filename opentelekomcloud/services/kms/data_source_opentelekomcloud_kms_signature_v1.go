@@ -0,0 +1,91 @@
+package kms
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceKmsSignatureV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKmsSignatureV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(keyIDRegexp,
+					"key ID has to be in UUID format"),
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, maxPlainTextLength),
+					validation.StringIsBase64,
+				),
+			},
+			"message_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "RAW",
+				ValidateFunc: validation.StringInSlice([]string{
+					"RAW", "DIGEST",
+				}, false),
+			},
+			"signing_algorithm": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"RSASSA_PSS_SHA_256", "RSASSA_PSS_SHA_384", "RSASSA_PSS_SHA_512",
+					"RSASSA_PKCS1_V1_5_SHA_256", "RSASSA_PKCS1_V1_5_SHA_384", "RSASSA_PKCS1_V1_5_SHA_512",
+					"ECDSA_SHA_256", "ECDSA_SHA_384", "ECDSA_SHA_512",
+				}, false),
+			},
+			"signature": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsSignatureV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	signed, err := Sign(client, SignOpts{
+		KeyID:            keyID,
+		Message:          d.Get("message").(string),
+		SigningAlgorithm: d.Get("signing_algorithm").(string),
+		MessageType:      d.Get("message_type").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error signing message with KMS key %s: %s", keyID, err)
+	}
+
+	d.SetId(hashcode.Strings([]string{keyID, signed.Signature}))
+	mErr := multierror.Append(nil,
+		d.Set("signature", signed.Signature),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting KMS signature fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package kms
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceKmsAliasV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKmsAliasV1Create,
+		ReadContext:   resourceKmsAliasV1Read,
+		UpdateContext: resourceKmsAliasV1Update,
+		DeleteContext: resourceKmsAliasV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(7, 255),
+					validation.StringMatch(regexp.MustCompile(`^alias/[a-zA-Z0-9:/_-]+$`),
+						"alias has to start with `alias/` and contain only letters, digits, `:`, `/`, `_` and `-`"),
+				),
+			},
+			"key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(keyIDRegexp, "key ID has to be in UUID format"),
+			},
+			"alias_urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKmsAliasV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.KmsKeyV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	alias := d.Get("alias").(string)
+	err = CreateAlias(client, CreateAliasOpts{
+		KeyID: d.Get("key_id").(string),
+		Alias: alias,
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating KMS alias: %s", err)
+	}
+	d.SetId(alias)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceKmsAliasV1Read(clientCtx, d, meta)
+}
+
+func resourceKmsAliasV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.KmsKeyV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	// alias can be associated with another key outside of terraform, so all aliases are checked
+	aliases, err := ListAliases(client, ListAliasesOpts{})
+	if err != nil {
+		return fmterr.Errorf("error listing KMS aliases: %s", err)
+	}
+
+	for _, alias := range aliases {
+		if alias.Alias != d.Id() {
+			continue
+		}
+		mErr := multierror.Append(nil,
+			d.Set("alias", alias.Alias),
+			d.Set("key_id", alias.KeyID),
+			d.Set("alias_urn", alias.AliasUrn),
+			d.Set("create_time", alias.CreateTime),
+			d.Set("update_time", alias.UpdateTime),
+			d.Set("region", config.GetRegion(d)),
+		)
+		if err := mErr.ErrorOrNil(); err != nil {
+			return fmterr.Errorf("error setting KMS alias fields: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] KMS alias %s not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceKmsAliasV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.KmsKeyV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if d.HasChange("key_id") {
+		err = AssociateAlias(client, AssociateAliasOpts{
+			Alias:       d.Id(),
+			TargetKeyID: d.Get("key_id").(string),
+		})
+		if err != nil {
+			return fmterr.Errorf("error associating KMS alias %s with key: %s", d.Id(), err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceKmsAliasV1Read(clientCtx, d, meta)
+}
+
+func resourceKmsAliasV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.KmsKeyV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	err = DeleteAlias(client, DeleteAliasOpts{
+		KeyID:   d.Get("key_id").(string),
+		Aliases: []string{d.Id()},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "KMS alias")
+	}
+	return nil
+}
@@ -27,6 +27,8 @@ const (
 	WaitingImportState    = "5"
 )
 
+var symmetricKeySpecs = []string{"AES_256", "SM4"}
+
 func ResourceKmsKeyV1() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKmsKeyV1Create,
@@ -111,6 +113,28 @@ func ResourceKmsKeyV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"key_spec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(append([]string{
+					"RSA_2048", "RSA_3072", "RSA_4096", "EC_P256", "EC_P384",
+				}, symmetricKeySpecs...), false),
+			},
+			"key_usage": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ENCRYPT_DECRYPT", "SIGN_VERIFY",
+				}, false),
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags": common.TagsSchema(),
 		},
 	}
@@ -139,15 +163,17 @@ func resourceKmsKeyV1Create(ctx context.Context, d *schema.ResourceData, meta in
 		return fmterr.Errorf("error validating KMS key: %s", err)
 	}
 
-	createOpts := keys.CreateOpts{
+	createOpts := CreateKeyOpts{
 		KeyAlias:       d.Get("key_alias").(string),
 		KeyDescription: d.Get("key_description").(string),
 		Realm:          d.Get("realm").(string),
+		KeySpec:        d.Get("key_spec").(string),
+		KeyUsage:       d.Get("key_usage").(string),
 		Origin:         d.Get("origin").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	key, err := keys.Create(client, createOpts)
+	key, err := CreateKey(client, createOpts)
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud key: %s", err)
 	}
@@ -203,7 +229,7 @@ func resourceKmsKeyV1Create(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if _, ok := d.GetOk("rotation_enabled"); ok && isKmsKey(d) {
+	if _, ok := d.GetOk("rotation_enabled"); ok && isKmsKey(d) && isSymmetricKey(d) {
 		rotationOpts := keys.RotationOpts{
 			KeyID: key.KeyID,
 		}
@@ -255,7 +281,7 @@ func resourceKmsKeyV1Read(ctx context.Context, d *schema.ResourceData, meta inte
 		return fmterr.Errorf(errCreationClient, err)
 	}
 
-	key, err := keys.Get(client, d.Id())
+	key, err := GetKeySpecification(client, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -300,6 +326,23 @@ func resourceKmsKeyV1Read(ctx context.Context, d *schema.ResourceData, meta inte
 		return fmterr.Errorf("error saving tags for OpenTelekomCloud KMS: %s", err)
 	}
 
+	mErr = multierror.Append(nil,
+		d.Set("key_spec", key.KeySpec),
+		d.Set("key_usage", key.KeyUsage),
+	)
+	if isSymmetricKey(d) {
+		mErr = multierror.Append(mErr, d.Set("public_key", ""))
+	} else {
+		publicKey, err := GetPublicKey(client, d.Id())
+		if err != nil {
+			return fmterr.Errorf("error fetching OpenTelekomCloud KMS public key: %s", err)
+		}
+		mErr = multierror.Append(mErr, d.Set("public_key", publicKey))
+	}
+	if mErr.ErrorOrNil() != nil {
+		return diag.FromErr(mErr)
+	}
+
 	// save rotation status
 	rotationOpts := keys.RotationOpts{
 		KeyID: key.KeyID,
@@ -361,7 +404,7 @@ func resourceKmsKeyV1Update(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if isKmsKey(d) {
+	if isKmsKey(d) && isSymmetricKey(d) {
 		if err := updateRotation(d, client, d.Id()); err != nil {
 			return diag.FromErr(err)
 		}
@@ -405,7 +448,7 @@ func resourceKmsKeyV1Delete(ctx context.Context, d *schema.ResourceData, meta in
 	// in a pending deletion state from when the instance was terminated.
 	// If this is true, just move on. It'll eventually delete.
 	if key.KeyState != PendingDeletionState {
-		if isKmsKey(d) {
+		if isKmsKey(d) && isSymmetricKey(d) {
 			rotationOpts := keys.RotationOpts{
 				KeyID: d.Id(),
 			}
@@ -482,6 +525,20 @@ func isKmsKey(d *schema.ResourceData) bool {
 	return true
 }
 
+// isSymmetricKey checks key spec, only symmetric keys can be rotated
+func isSymmetricKey(d *schema.ResourceData) bool {
+	spec := d.Get("key_spec").(string)
+	if spec == "" {
+		return true
+	}
+	for _, symmetric := range symmetricKeySpecs {
+		if spec == symmetric {
+			return true
+		}
+	}
+	return false
+}
+
 func updateRotation(d *schema.ResourceData, client *golangsdk.ServiceClient, keyID string) error {
 	rotationEnabled := d.Get("rotation_enabled").(bool)
 
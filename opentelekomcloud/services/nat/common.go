@@ -1,5 +1,13 @@
 package nat
 
+import "regexp"
+
 const (
-	ErrCreationClient = "error creating OpenTelekomCloud NATv2 client: %w"
+	ErrCreationClient   = "error creating OpenTelekomCloud NATv2 client: %w"
+	ErrCreationV3Client = "error creating OpenTelekomCloud NATv3 client: %w"
+)
+
+var (
+	portRangeRegex   = regexp.MustCompile(`^\d{1,5}-\d{1,5}$`)
+	portOrRangeRegex = regexp.MustCompile(`^\d{1,5}(-\d{1,5})?$`)
 )
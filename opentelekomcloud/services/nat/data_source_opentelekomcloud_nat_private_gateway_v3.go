@@ -0,0 +1,137 @@
+package nat
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceNatPrivateGatewayV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNatPrivateGatewayV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"spec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ngport_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNatPrivateGatewayV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	listOpts := ListPrivateGatewaysOpts{
+		ID:          d.Get("gateway_id").(string),
+		Name:        d.Get("name").(string),
+		Spec:        d.Get("spec").(string),
+		Status:      d.Get("status").(string),
+		VpcID:       d.Get("vpc_id").(string),
+		VirsubnetID: d.Get("subnet_id").(string),
+	}
+	gateways, err := ListPrivateGateways(client, listOpts)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve private NAT gateways: %w", err)
+	}
+
+	if len(gateways) < 1 {
+		return common.DataSourceTooFewDiag
+	}
+	if len(gateways) > 1 {
+		return common.DataSourceTooManyDiag
+	}
+	gateway := gateways[0]
+
+	d.SetId(gateway.ID)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("gateway_id", gateway.ID),
+		d.Set("name", gateway.Name),
+		d.Set("description", gateway.Description),
+		d.Set("spec", gateway.Spec),
+		d.Set("status", gateway.Status),
+		d.Set("project_id", gateway.ProjectID),
+		d.Set("created_at", gateway.CreatedAt),
+		d.Set("updated_at", gateway.UpdatedAt),
+		d.Set("tags", common.TagsToMap(gateway.Tags)),
+	)
+	if len(gateway.DownlinkVpcs) != 0 {
+		mErr = multierror.Append(mErr,
+			d.Set("vpc_id", gateway.DownlinkVpcs[0].VpcID),
+			d.Set("subnet_id", gateway.DownlinkVpcs[0].VirsubnetID),
+			d.Set("ngport_ip_address", gateway.DownlinkVpcs[0].NgportIPAddress),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package nat
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceNatPrivateTransitIPsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNatPrivateTransitIPsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"transit_ip_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"transit_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNatPrivateTransitIPsV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	listOpts := ListTransitIPsOpts{
+		ID:                 d.Get("transit_ip_id").(string),
+		IPAddress:          d.Get("ip_address").(string),
+		GatewayID:          d.Get("gateway_id").(string),
+		VirsubnetID:        d.Get("subnet_id").(string),
+		NetworkInterfaceID: d.Get("network_interface_id").(string),
+	}
+	transitIPs, err := ListTransitIPs(client, listOpts)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve private NAT transit IPs: %w", err)
+	}
+
+	ids := make([]string, len(transitIPs))
+	result := make([]map[string]interface{}, len(transitIPs))
+	for i, transitIP := range transitIPs {
+		ids[i] = transitIP.ID
+		result[i] = map[string]interface{}{
+			"id":                   transitIP.ID,
+			"ip_address":           transitIP.IPAddress,
+			"gateway_id":           transitIP.GatewayID,
+			"subnet_id":            transitIP.VirsubnetID,
+			"network_interface_id": transitIP.NetworkInterfaceID,
+			"project_id":           transitIP.ProjectID,
+			"created_at":           transitIP.CreatedAt,
+			"updated_at":           transitIP.UpdatedAt,
+			"tags":                 common.TagsToMap(transitIP.Tags),
+		}
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("transit_ips", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
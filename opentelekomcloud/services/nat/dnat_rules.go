@@ -0,0 +1,57 @@
+package nat
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/dnatrules"
+)
+
+// Port ranges of the DNAT rules are not covered by gophertelekomcloud yet.
+
+type dnatRuleCreateOpts struct {
+	NatGatewayID             string `json:"nat_gateway_id"`
+	PortID                   string `json:"port_id,omitempty"`
+	PrivateIp                string `json:"private_ip,omitempty"`
+	InternalServicePort      *int   `json:"internal_service_port,omitempty"`
+	InternalServicePortRange string `json:"internal_service_port_range,omitempty"`
+	FloatingIpID             string `json:"floating_ip_id"`
+	ExternalServicePort      *int   `json:"external_service_port,omitempty"`
+	ExternalServicePortRange string `json:"external_service_port_range,omitempty"`
+	Protocol                 string `json:"protocol"`
+}
+
+type dnatRule struct {
+	dnatrules.DnatRule
+	InternalServicePortRange string `json:"internal_service_port_range"`
+	ExternalServicePortRange string `json:"external_service_port_range"`
+}
+
+func createDnatRule(client *golangsdk.ServiceClient, opts dnatRuleCreateOpts) (*dnatRule, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "dnat_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v2.0/dnat_rules
+	var res struct {
+		DnatRule dnatRule `json:"dnat_rule"`
+	}
+	_, err = client.Post(client.ServiceURL("dnat_rules"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.DnatRule, nil
+}
+
+func getDnatRule(client *golangsdk.ServiceClient, id string) (*dnatRule, error) {
+	// GET /v2.0/dnat_rules/{dnat_rule_id}
+	var res struct {
+		DnatRule dnatRule `json:"dnat_rule"`
+	}
+	_, err := client.Get(client.ServiceURL("dnat_rules", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.DnatRule, nil
+}
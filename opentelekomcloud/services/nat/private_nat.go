@@ -0,0 +1,399 @@
+package nat
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+// The private NAT v3 API is not covered by gophertelekomcloud yet.
+
+type PrivateGatewayDownlinkVpc struct {
+	// ID of the VPC where the private NAT gateway works.
+	VpcID string `json:"vpc_id,omitempty"`
+	// ID of the subnet where the private NAT gateway works.
+	VirsubnetID string `json:"virsubnet_id"`
+	// Private IP address of the private NAT gateway.
+	NgportIPAddress string `json:"ngport_ip_address,omitempty"`
+}
+
+type CreatePrivateGatewayOpts struct {
+	Name         string                      `json:"name"`
+	Description  string                      `json:"description,omitempty"`
+	Spec         string                      `json:"spec,omitempty"`
+	DownlinkVpcs []PrivateGatewayDownlinkVpc `json:"downlink_vpcs"`
+	Tags         []tags.ResourceTag          `json:"tags,omitempty"`
+}
+
+type UpdatePrivateGatewayOpts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Spec        string  `json:"spec,omitempty"`
+}
+
+type ListPrivateGatewaysOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Spec        string `q:"spec"`
+	Status      string `q:"status"`
+	VpcID       string `q:"vpc_id"`
+	VirsubnetID string `q:"virsubnet_id"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+}
+
+type PrivateGateway struct {
+	ID           string                      `json:"id"`
+	ProjectID    string                      `json:"project_id"`
+	Name         string                      `json:"name"`
+	Description  string                      `json:"description"`
+	Spec         string                      `json:"spec"`
+	Status       string                      `json:"status"`
+	DownlinkVpcs []PrivateGatewayDownlinkVpc `json:"downlink_vpcs"`
+	Tags         []tags.ResourceTag          `json:"tags"`
+	CreatedAt    string                      `json:"created_at"`
+	UpdatedAt    string                      `json:"updated_at"`
+}
+
+type CreateTransitIPOpts struct {
+	// ID of the transit subnet.
+	VirsubnetID string             `json:"virsubnet_id"`
+	IPAddress   string             `json:"ip_address,omitempty"`
+	Tags        []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+type ListTransitIPsOpts struct {
+	ID                 string `q:"id"`
+	IPAddress          string `q:"ip_address"`
+	GatewayID          string `q:"gateway_id"`
+	VirsubnetID        string `q:"virsubnet_id"`
+	NetworkInterfaceID string `q:"network_interface_id"`
+	Limit              int    `q:"limit"`
+	Marker             string `q:"marker"`
+}
+
+type TransitIP struct {
+	ID                 string             `json:"id"`
+	ProjectID          string             `json:"project_id"`
+	NetworkInterfaceID string             `json:"network_interface_id"`
+	IPAddress          string             `json:"ip_address"`
+	GatewayID          string             `json:"gateway_id"`
+	VirsubnetID        string             `json:"virsubnet_id"`
+	Tags               []tags.ResourceTag `json:"tags"`
+	CreatedAt          string             `json:"created_at"`
+	UpdatedAt          string             `json:"updated_at"`
+}
+
+type CreatePrivateSnatRuleOpts struct {
+	GatewayID    string   `json:"gateway_id"`
+	Cidr         string   `json:"cidr,omitempty"`
+	VirsubnetID  string   `json:"virsubnet_id,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	TransitIPIDs []string `json:"transit_ip_ids"`
+}
+
+type UpdatePrivateSnatRuleOpts struct {
+	Description  *string  `json:"description,omitempty"`
+	TransitIPIDs []string `json:"transit_ip_ids,omitempty"`
+}
+
+type TransitIPAssociation struct {
+	TransitIPID      string `json:"transit_ip_id"`
+	TransitIPAddress string `json:"transit_ip_address"`
+}
+
+type PrivateSnatRule struct {
+	ID                    string                 `json:"id"`
+	ProjectID             string                 `json:"project_id"`
+	GatewayID             string                 `json:"gateway_id"`
+	Cidr                  string                 `json:"cidr"`
+	VirsubnetID           string                 `json:"virsubnet_id"`
+	Description           string                 `json:"description"`
+	TransitIPAssociations []TransitIPAssociation `json:"transit_ip_associations"`
+	Status                string                 `json:"status"`
+	CreatedAt             string                 `json:"created_at"`
+	UpdatedAt             string                 `json:"updated_at"`
+}
+
+type PrivateDnatRuleOpts struct {
+	// Can't be updated.
+	GatewayID   string `json:"gateway_id,omitempty"`
+	TransitIPID string `json:"transit_ip_id,omitempty"`
+	// Single port or port range, e.g. `80-90`.
+	TransitServicePort string `json:"transit_service_port,omitempty"`
+	Protocol           string `json:"protocol,omitempty"`
+	// Single port or port range, e.g. `80-90`.
+	InternalServicePort string  `json:"internal_service_port,omitempty"`
+	PrivateIPAddress    string  `json:"private_ip_address,omitempty"`
+	NetworkInterfaceID  string  `json:"network_interface_id,omitempty"`
+	Description         *string `json:"description,omitempty"`
+}
+
+type PrivateDnatRule struct {
+	ID                  string `json:"id"`
+	ProjectID           string `json:"project_id"`
+	GatewayID           string `json:"gateway_id"`
+	TransitIPID         string `json:"transit_ip_id"`
+	TransitServicePort  string `json:"transit_service_port"`
+	Protocol            string `json:"protocol"`
+	InternalServicePort string `json:"internal_service_port"`
+	PrivateIPAddress    string `json:"private_ip_address"`
+	NetworkInterfaceID  string `json:"network_interface_id"`
+	Type                string `json:"type"`
+	Description         string `json:"description"`
+	Status              string `json:"status"`
+	CreatedAt           string `json:"created_at"`
+	UpdatedAt           string `json:"updated_at"`
+}
+
+type privateNatPageInfo struct {
+	NextMarker string `json:"next_marker"`
+}
+
+func CreatePrivateGateway(client *golangsdk.ServiceClient, opts CreatePrivateGatewayOpts) (*PrivateGateway, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "gateway")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v3/{project_id}/private-nat/gateways
+	var res struct {
+		Gateway PrivateGateway `json:"gateway"`
+	}
+	_, err = client.Post(client.ServiceURL("private-nat", "gateways"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Gateway, nil
+}
+
+func GetPrivateGateway(client *golangsdk.ServiceClient, id string) (*PrivateGateway, error) {
+	// GET /v3/{project_id}/private-nat/gateways/{gateway_id}
+	var res struct {
+		Gateway PrivateGateway `json:"gateway"`
+	}
+	_, err := client.Get(client.ServiceURL("private-nat", "gateways", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Gateway, nil
+}
+
+func ListPrivateGateways(client *golangsdk.ServiceClient, opts ListPrivateGatewaysOpts) ([]PrivateGateway, error) {
+	var gateways []PrivateGateway
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("private-nat", "gateways").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v3/{project_id}/private-nat/gateways
+		var res struct {
+			Gateways []PrivateGateway   `json:"gateways"`
+			PageInfo privateNatPageInfo `json:"page_info"`
+		}
+		_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		gateways = append(gateways, res.Gateways...)
+		if res.PageInfo.NextMarker == "" || len(res.Gateways) == 0 {
+			return gateways, nil
+		}
+		opts.Marker = res.PageInfo.NextMarker
+	}
+}
+
+func UpdatePrivateGateway(client *golangsdk.ServiceClient, id string, opts UpdatePrivateGatewayOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "gateway")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v3/{project_id}/private-nat/gateways/{gateway_id}
+	_, err = client.Put(client.ServiceURL("private-nat", "gateways", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeletePrivateGateway(client *golangsdk.ServiceClient, id string) error {
+	// DELETE /v3/{project_id}/private-nat/gateways/{gateway_id}
+	_, err := client.Delete(client.ServiceURL("private-nat", "gateways", id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+func CreateTransitIP(client *golangsdk.ServiceClient, opts CreateTransitIPOpts) (*TransitIP, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "transit_ip")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v3/{project_id}/private-nat/transit-ips
+	var res struct {
+		TransitIP TransitIP `json:"transit_ip"`
+	}
+	_, err = client.Post(client.ServiceURL("private-nat", "transit-ips"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.TransitIP, nil
+}
+
+func GetTransitIP(client *golangsdk.ServiceClient, id string) (*TransitIP, error) {
+	// GET /v3/{project_id}/private-nat/transit-ips/{transit_ip_id}
+	var res struct {
+		TransitIP TransitIP `json:"transit_ip"`
+	}
+	_, err := client.Get(client.ServiceURL("private-nat", "transit-ips", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.TransitIP, nil
+}
+
+func ListTransitIPs(client *golangsdk.ServiceClient, opts ListTransitIPsOpts) ([]TransitIP, error) {
+	var transitIPs []TransitIP
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("private-nat", "transit-ips").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v3/{project_id}/private-nat/transit-ips
+		var res struct {
+			TransitIPs []TransitIP        `json:"transit_ips"`
+			PageInfo   privateNatPageInfo `json:"page_info"`
+		}
+		_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		transitIPs = append(transitIPs, res.TransitIPs...)
+		if res.PageInfo.NextMarker == "" || len(res.TransitIPs) == 0 {
+			return transitIPs, nil
+		}
+		opts.Marker = res.PageInfo.NextMarker
+	}
+}
+
+func DeleteTransitIP(client *golangsdk.ServiceClient, id string) error {
+	// DELETE /v3/{project_id}/private-nat/transit-ips/{transit_ip_id}
+	_, err := client.Delete(client.ServiceURL("private-nat", "transit-ips", id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+func CreatePrivateSnatRule(client *golangsdk.ServiceClient, opts CreatePrivateSnatRuleOpts) (*PrivateSnatRule, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "snat_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v3/{project_id}/private-nat/snat-rules
+	var res struct {
+		SnatRule PrivateSnatRule `json:"snat_rule"`
+	}
+	_, err = client.Post(client.ServiceURL("private-nat", "snat-rules"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.SnatRule, nil
+}
+
+func GetPrivateSnatRule(client *golangsdk.ServiceClient, id string) (*PrivateSnatRule, error) {
+	// GET /v3/{project_id}/private-nat/snat-rules/{snat_rule_id}
+	var res struct {
+		SnatRule PrivateSnatRule `json:"snat_rule"`
+	}
+	_, err := client.Get(client.ServiceURL("private-nat", "snat-rules", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.SnatRule, nil
+}
+
+func UpdatePrivateSnatRule(client *golangsdk.ServiceClient, id string, opts UpdatePrivateSnatRuleOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "snat_rule")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v3/{project_id}/private-nat/snat-rules/{snat_rule_id}
+	_, err = client.Put(client.ServiceURL("private-nat", "snat-rules", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeletePrivateSnatRule(client *golangsdk.ServiceClient, id string) error {
+	// DELETE /v3/{project_id}/private-nat/snat-rules/{snat_rule_id}
+	_, err := client.Delete(client.ServiceURL("private-nat", "snat-rules", id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+func CreatePrivateDnatRule(client *golangsdk.ServiceClient, opts PrivateDnatRuleOpts) (*PrivateDnatRule, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "dnat_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v3/{project_id}/private-nat/dnat-rules
+	var res struct {
+		DnatRule PrivateDnatRule `json:"dnat_rule"`
+	}
+	_, err = client.Post(client.ServiceURL("private-nat", "dnat-rules"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.DnatRule, nil
+}
+
+func GetPrivateDnatRule(client *golangsdk.ServiceClient, id string) (*PrivateDnatRule, error) {
+	// GET /v3/{project_id}/private-nat/dnat-rules/{dnat_rule_id}
+	var res struct {
+		DnatRule PrivateDnatRule `json:"dnat_rule"`
+	}
+	_, err := client.Get(client.ServiceURL("private-nat", "dnat-rules", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.DnatRule, nil
+}
+
+func UpdatePrivateDnatRule(client *golangsdk.ServiceClient, id string, opts PrivateDnatRuleOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "dnat_rule")
+	if err != nil {
+		return err
+	}
+
+	// PUT /v3/{project_id}/private-nat/dnat-rules/{dnat_rule_id}
+	_, err = client.Put(client.ServiceURL("private-nat", "dnat-rules", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func DeletePrivateDnatRule(client *golangsdk.ServiceClient, id string) error {
+	// DELETE /v3/{project_id}/private-nat/dnat-rules/{dnat_rule_id}
+	_, err := client.Delete(client.ServiceURL("private-nat", "dnat-rules", id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
@@ -41,9 +41,19 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 			},
 			"internal_service_port": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 65535),
+				ExactlyOneOf: []string{"internal_service_port", "internal_service_port_range"},
+			},
+			"internal_service_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(portRangeRegex, "must be a port range, e.g. `80-90`"),
+				RequiredWith: []string{"external_service_port_range"},
 			},
 			"nat_gateway_id": {
 				Type:         schema.TypeString,
@@ -75,9 +85,19 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 			},
 			"external_service_port": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 65535),
+				ExactlyOneOf: []string{"external_service_port", "external_service_port_range"},
+			},
+			"external_service_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(portRangeRegex, "must be a port range, e.g. `80-90`"),
+				RequiredWith: []string{"internal_service_port_range"},
 			},
 			"created_at": {
 				Type:     schema.TypeString,
@@ -112,16 +132,20 @@ func resourceNatDnatRuleCreate(ctx context.Context, d *schema.ResourceData, meta
 		return fmterr.Errorf("both `port_id` and `private_ip` are empty, must specify one of them.")
 	}
 
-	externalServicePort := d.Get("external_service_port").(int)
-	internalServicePort := d.Get("internal_service_port").(int)
-	createOpts := dnatrules.CreateOpts{
-		NatGatewayID:        d.Get("nat_gateway_id").(string),
-		PortID:              portID.(string),
-		PrivateIp:           privateIp.(string),
-		InternalServicePort: &internalServicePort,
-		FloatingIpID:        d.Get("floating_ip_id").(string),
-		ExternalServicePort: &externalServicePort,
-		Protocol:            d.Get("protocol").(string),
+	createOpts := dnatRuleCreateOpts{
+		NatGatewayID:             d.Get("nat_gateway_id").(string),
+		PortID:                   portID.(string),
+		PrivateIp:                privateIp.(string),
+		InternalServicePortRange: d.Get("internal_service_port_range").(string),
+		FloatingIpID:             d.Get("floating_ip_id").(string),
+		ExternalServicePortRange: d.Get("external_service_port_range").(string),
+		Protocol:                 d.Get("protocol").(string),
+	}
+	if createOpts.InternalServicePortRange == "" {
+		externalServicePort := d.Get("external_service_port").(int)
+		internalServicePort := d.Get("internal_service_port").(int)
+		createOpts.InternalServicePort = &internalServicePort
+		createOpts.ExternalServicePort = &externalServicePort
 	}
 
 	rule, err := createRuleWithRetry(ctx, client, createOpts, time.Minute)
@@ -151,11 +175,11 @@ var createRulePollInterval = 5 * time.Second
 
 // createRuleWithRetry retries creation of DNAT rule in case err 400 is received (handling DnatRuleInValidPortID erorr)
 // time between requests is set by createRulePollInterval
-func createRuleWithRetry(ctx context.Context, client *golangsdk.ServiceClient, opts dnatrules.CreateOpts, timeout time.Duration) (*dnatrules.DnatRule, error) {
-	var rule *dnatrules.DnatRule
+func createRuleWithRetry(ctx context.Context, client *golangsdk.ServiceClient, opts dnatRuleCreateOpts, timeout time.Duration) (*dnatRule, error) {
+	var rule *dnatRule
 	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		var err error
-		rule, err = dnatrules.Create(client, opts)
+		rule, err = createDnatRule(client, opts)
 		if err != nil {
 			// we are retrying DnatRuleInValidPortID which is HTTP 400
 			if _, ok := err.(golangsdk.ErrDefault400); ok {
@@ -179,7 +203,7 @@ func resourceNatDnatRuleRead(_ context.Context, d *schema.ResourceData, meta int
 	if err != nil {
 		return fmterr.Errorf(ErrCreationClient, err)
 	}
-	dnatRule, err := dnatrules.Get(client, d.Id())
+	dnatRule, err := getDnatRule(client, d.Id())
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "dnat rule")
 	}
@@ -192,6 +216,8 @@ func resourceNatDnatRuleRead(_ context.Context, d *schema.ResourceData, meta int
 		d.Set("private_ip", dnatRule.PrivateIp),
 		d.Set("protocol", dnatRule.Protocol),
 		d.Set("external_service_port", dnatRule.ExternalServicePort),
+		d.Set("internal_service_port_range", dnatRule.InternalServicePortRange),
+		d.Set("external_service_port_range", dnatRule.ExternalServicePortRange),
 		d.Set("floating_ip_address", dnatRule.FloatingIpAddress),
 		d.Set("status", dnatRule.Status),
 		d.Set("tenant_id", dnatRule.ProjectId),
@@ -0,0 +1,215 @@
+package nat
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceNatPrivateDnatRuleV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNatPrivateDnatRuleV3Create,
+		ReadContext:   resourceNatPrivateDnatRuleV3Read,
+		UpdateContext: resourceNatPrivateDnatRuleV3Update,
+		DeleteContext: resourceNatPrivateDnatRuleV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"transit_ip_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "any",
+				ValidateFunc: validation.StringInSlice([]string{
+					"tcp", "udp", "any",
+				}, false),
+			},
+			"transit_service_port": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringMatch(portOrRangeRegex,
+					"must be a port or a port range, e.g. `80` or `80-90`"),
+			},
+			"internal_service_port": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringMatch(portOrRangeRegex,
+					"must be a port or a port range, e.g. `80` or `80-90`"),
+			},
+			"private_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPv4Address,
+				ExactlyOneOf: []string{"private_ip_address", "network_interface_id"},
+			},
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNatPrivateDnatRuleV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	description := d.Get("description").(string)
+	createOpts := PrivateDnatRuleOpts{
+		GatewayID:           d.Get("gateway_id").(string),
+		TransitIPID:         d.Get("transit_ip_id").(string),
+		Protocol:            d.Get("protocol").(string),
+		TransitServicePort:  d.Get("transit_service_port").(string),
+		InternalServicePort: d.Get("internal_service_port").(string),
+		PrivateIPAddress:    d.Get("private_ip_address").(string),
+		NetworkInterfaceID:  d.Get("network_interface_id").(string),
+		Description:         &description,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := CreatePrivateDnatRule(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating private DNAT Rule: %w", err)
+	}
+	d.SetId(rule.ID)
+
+	return resourceNatPrivateDnatRuleV3Read(ctx, d, meta)
+}
+
+func resourceNatPrivateDnatRuleV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	rule, err := GetPrivateDnatRule(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "private DNAT Rule")
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("gateway_id", rule.GatewayID),
+		d.Set("transit_ip_id", rule.TransitIPID),
+		d.Set("protocol", rule.Protocol),
+		d.Set("transit_service_port", rule.TransitServicePort),
+		d.Set("internal_service_port", rule.InternalServicePort),
+		d.Set("private_ip_address", rule.PrivateIPAddress),
+		d.Set("network_interface_id", rule.NetworkInterfaceID),
+		d.Set("description", rule.Description),
+		d.Set("type", rule.Type),
+		d.Set("status", rule.Status),
+		d.Set("project_id", rule.ProjectID),
+		d.Set("created_at", rule.CreatedAt),
+		d.Set("updated_at", rule.UpdatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceNatPrivateDnatRuleV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	description := d.Get("description").(string)
+	updateOpts := PrivateDnatRuleOpts{
+		TransitIPID:         d.Get("transit_ip_id").(string),
+		Protocol:            d.Get("protocol").(string),
+		TransitServicePort:  d.Get("transit_service_port").(string),
+		InternalServicePort: d.Get("internal_service_port").(string),
+		Description:         &description,
+	}
+	// backend is either IP address or the network interface
+	if d.HasChange("network_interface_id") {
+		updateOpts.NetworkInterfaceID = d.Get("network_interface_id").(string)
+	} else if d.HasChange("private_ip_address") {
+		updateOpts.PrivateIPAddress = d.Get("private_ip_address").(string)
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	if err := UpdatePrivateDnatRule(client, d.Id(), updateOpts); err != nil {
+		return fmterr.Errorf("error updating private DNAT Rule: %w", err)
+	}
+
+	return resourceNatPrivateDnatRuleV3Read(ctx, d, meta)
+}
+
+func resourceNatPrivateDnatRuleV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	if err := DeletePrivateDnatRule(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "private DNAT Rule")
+	}
+
+	d.SetId("")
+	return nil
+}
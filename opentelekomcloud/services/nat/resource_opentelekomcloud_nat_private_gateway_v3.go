@@ -0,0 +1,250 @@
+package nat
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceNatPrivateGatewayV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNatPrivateGatewayV3Create,
+		ReadContext:   resourceNatPrivateGatewayV3Read,
+		UpdateContext: resourceNatPrivateGatewayV3Update,
+		DeleteContext: resourceNatPrivateGatewayV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"spec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Small", "Medium", "Large", "Extra-large",
+				}, false),
+			},
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ngport_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": common.TagsSchema(),
+		},
+	}
+}
+
+func resourceNatPrivateGatewayV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	createOpts := CreatePrivateGatewayOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Spec:        d.Get("spec").(string),
+		DownlinkVpcs: []PrivateGatewayDownlinkVpc{
+			{VirsubnetID: d.Get("subnet_id").(string)},
+		},
+		Tags: common.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	gateway, err := CreatePrivateGateway(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating private NAT Gateway: %w", err)
+	}
+	d.SetId(gateway.ID)
+
+	log.Printf("[DEBUG] Waiting for OpenTelekomCloud private NAT Gateway (%s) to become available.", gateway.ID)
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForPrivateGatewayActive(client, gateway.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for private NAT Gateway to become active: %w", err)
+	}
+
+	return resourceNatPrivateGatewayV3Read(ctx, d, meta)
+}
+
+func resourceNatPrivateGatewayV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	gateway, err := GetPrivateGateway(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "private NAT Gateway")
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", gateway.Name),
+		d.Set("description", gateway.Description),
+		d.Set("spec", gateway.Spec),
+		d.Set("status", gateway.Status),
+		d.Set("project_id", gateway.ProjectID),
+		d.Set("created_at", gateway.CreatedAt),
+		d.Set("updated_at", gateway.UpdatedAt),
+		d.Set("tags", common.TagsToMap(gateway.Tags)),
+	)
+	if len(gateway.DownlinkVpcs) != 0 {
+		mErr = multierror.Append(mErr,
+			d.Set("subnet_id", gateway.DownlinkVpcs[0].VirsubnetID),
+			d.Set("vpc_id", gateway.DownlinkVpcs[0].VpcID),
+			d.Set("ngport_ip_address", gateway.DownlinkVpcs[0].NgportIPAddress),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceNatPrivateGatewayV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	if d.HasChanges("name", "description", "spec") {
+		description := d.Get("description").(string)
+		updateOpts := UpdatePrivateGatewayOpts{
+			Name:        d.Get("name").(string),
+			Description: &description,
+		}
+		if d.HasChange("spec") {
+			updateOpts.Spec = d.Get("spec").(string)
+		}
+		if err := UpdatePrivateGateway(client, d.Id(), updateOpts); err != nil {
+			return fmterr.Errorf("error updating private NAT Gateway: %w", err)
+		}
+	}
+
+	if err := common.UpdateResourceTags(client, d, "private-nat-gateways", d.Id()); err != nil {
+		return fmterr.Errorf("error updating tags of private NAT Gateway %s: %w", d.Id(), err)
+	}
+
+	return resourceNatPrivateGatewayV3Read(ctx, d, meta)
+}
+
+func resourceNatPrivateGatewayV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	if err := DeletePrivateGateway(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "private NAT Gateway")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForPrivateGatewayDelete(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error deleting OpenTelekomCloud private NAT Gateway: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForPrivateGatewayActive(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		gateway, err := GetPrivateGateway(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		log.Printf("[DEBUG] OpenTelekomCloud private NAT Gateway: %+v", gateway)
+		if gateway.Status == "ACTIVE" {
+			return gateway, "ACTIVE", nil
+		}
+		return gateway, "", nil
+	}
+}
+
+func waitForPrivateGatewayDelete(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		gateway, err := GetPrivateGateway(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted OpenTelekomCloud private NAT Gateway %s", id)
+				return gateway, "DELETED", nil
+			}
+			return gateway, "ACTIVE", err
+		}
+		return gateway, "ACTIVE", nil
+	}
+}
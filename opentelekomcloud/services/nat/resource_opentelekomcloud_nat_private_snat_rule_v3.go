@@ -0,0 +1,189 @@
+package nat
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceNatPrivateSnatRuleV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNatPrivateSnatRuleV3Create,
+		ReadContext:   resourceNatPrivateSnatRuleV3Read,
+		UpdateContext: resourceNatPrivateSnatRuleV3Update,
+		DeleteContext: resourceNatPrivateSnatRuleV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				ExactlyOneOf: []string{"cidr", "subnet_id"},
+			},
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"transit_ip_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"transit_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNatPrivateSnatRuleV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	createOpts := CreatePrivateSnatRuleOpts{
+		GatewayID:    d.Get("gateway_id").(string),
+		Cidr:         d.Get("cidr").(string),
+		VirsubnetID:  d.Get("subnet_id").(string),
+		Description:  d.Get("description").(string),
+		TransitIPIDs: common.ExpandToStringSlice(d.Get("transit_ip_ids").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := CreatePrivateSnatRule(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating private SNAT Rule: %w", err)
+	}
+	d.SetId(rule.ID)
+
+	return resourceNatPrivateSnatRuleV3Read(ctx, d, meta)
+}
+
+func resourceNatPrivateSnatRuleV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	rule, err := GetPrivateSnatRule(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "private SNAT Rule")
+	}
+
+	var transitIPIDs, transitIPAddresses []string
+	for _, association := range rule.TransitIPAssociations {
+		transitIPIDs = append(transitIPIDs, association.TransitIPID)
+		transitIPAddresses = append(transitIPAddresses, association.TransitIPAddress)
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("gateway_id", rule.GatewayID),
+		d.Set("cidr", rule.Cidr),
+		d.Set("subnet_id", rule.VirsubnetID),
+		d.Set("description", rule.Description),
+		d.Set("transit_ip_ids", transitIPIDs),
+		d.Set("transit_ip_addresses", transitIPAddresses),
+		d.Set("status", rule.Status),
+		d.Set("project_id", rule.ProjectID),
+		d.Set("created_at", rule.CreatedAt),
+		d.Set("updated_at", rule.UpdatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceNatPrivateSnatRuleV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	var updateOpts UpdatePrivateSnatRuleOpts
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("transit_ip_ids") {
+		updateOpts.TransitIPIDs = common.ExpandToStringSlice(d.Get("transit_ip_ids").(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	if err := UpdatePrivateSnatRule(client, d.Id(), updateOpts); err != nil {
+		return fmterr.Errorf("error updating private SNAT Rule: %w", err)
+	}
+
+	return resourceNatPrivateSnatRuleV3Read(ctx, d, meta)
+}
+
+func resourceNatPrivateSnatRuleV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	if err := DeletePrivateSnatRule(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "private SNAT Rule")
+	}
+
+	d.SetId("")
+	return nil
+}
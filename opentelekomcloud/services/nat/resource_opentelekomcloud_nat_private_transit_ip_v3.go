@@ -0,0 +1,152 @@
+package nat
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceNatPrivateTransitIPV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNatPrivateTransitIPV3Create,
+		ReadContext:   resourceNatPrivateTransitIPV3Read,
+		UpdateContext: resourceNatPrivateTransitIPV3Update,
+		DeleteContext: resourceNatPrivateTransitIPV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": common.TagsSchema(),
+		},
+	}
+}
+
+func resourceNatPrivateTransitIPV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	createOpts := CreateTransitIPOpts{
+		VirsubnetID: d.Get("subnet_id").(string),
+		IPAddress:   d.Get("ip_address").(string),
+		Tags:        common.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	transitIP, err := CreateTransitIP(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating private NAT transit IP: %w", err)
+	}
+	d.SetId(transitIP.ID)
+
+	return resourceNatPrivateTransitIPV3Read(ctx, d, meta)
+}
+
+func resourceNatPrivateTransitIPV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	transitIP, err := GetTransitIP(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "private NAT transit IP")
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("subnet_id", transitIP.VirsubnetID),
+		d.Set("ip_address", transitIP.IPAddress),
+		d.Set("network_interface_id", transitIP.NetworkInterfaceID),
+		d.Set("gateway_id", transitIP.GatewayID),
+		d.Set("project_id", transitIP.ProjectID),
+		d.Set("created_at", transitIP.CreatedAt),
+		d.Set("updated_at", transitIP.UpdatedAt),
+		d.Set("tags", common.TagsToMap(transitIP.Tags)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceNatPrivateTransitIPV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	if err := common.UpdateResourceTags(client, d, "transit-ips", d.Id()); err != nil {
+		return fmterr.Errorf("error updating tags of private NAT transit IP %s: %w", d.Id(), err)
+	}
+
+	return resourceNatPrivateTransitIPV3Read(ctx, d, meta)
+}
+
+func resourceNatPrivateTransitIPV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV3Client, err)
+	}
+
+	if err := DeleteTransitIP(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "private NAT transit IP")
+	}
+
+	d.SetId("")
+	return nil
+}
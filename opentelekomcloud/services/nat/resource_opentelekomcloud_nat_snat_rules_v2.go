@@ -0,0 +1,336 @@
+package nat
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/snatrules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceNatSnatRulesV2 manages a set of SNAT rules sharing the same EIPs,
+// one rule is created for every CIDR block and every network.
+func ResourceNatSnatRulesV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNatSnatRulesV2Create,
+		ReadContext:   resourceNatSnatRulesV2Read,
+		UpdateContext: resourceNatSnatRulesV2Update,
+		DeleteContext: resourceNatSnatRulesV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"nat_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"floating_ip_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+			"cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				AtLeastOneOf: []string{"cidrs", "network_ids"},
+			},
+			"network_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+			"source_type": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floating_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNatSnatRulesV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationClient, err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	cidrs := common.ExpandToStringSlice(d.Get("cidrs").(*schema.Set).List())
+	networkIDs := common.ExpandToStringSlice(d.Get("network_ids").(*schema.Set).List())
+	ruleIDs, err := createSnatRules(ctx, client, d, cidrs, networkIDs, d.Timeout(schema.TimeoutCreate))
+	if err := d.Set("rules", snatRuleIDsToList(ruleIDs)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNatSnatRulesV2Read(ctx, d, meta)
+}
+
+func resourceNatSnatRulesV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationClient, err)
+	}
+
+	var (
+		rules       []map[string]interface{}
+		cidrs       []string
+		networkIDs  []string
+		floatingIPs []string
+	)
+	for _, id := range stateSnatRuleIDs(d) {
+		rule, err := snatrules.Get(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[WARN] SNAT Rule %s of %s is gone", id, d.Id())
+				continue
+			}
+			return fmterr.Errorf("error retrieving SNAT Rule %s: %w", id, err)
+		}
+		rules = append(rules, map[string]interface{}{
+			"id":                  rule.ID,
+			"cidr":                rule.Cidr,
+			"network_id":          rule.NetworkID,
+			"floating_ip_address": rule.FloatingIPAddress,
+			"status":              rule.Status,
+		})
+		if rule.NetworkID != "" {
+			networkIDs = append(networkIDs, rule.NetworkID)
+		} else {
+			cidrs = append(cidrs, rule.Cidr)
+		}
+		floatingIPs = strings.Split(rule.FloatingIPID, ",")
+	}
+	if len(rules) == 0 {
+		log.Printf("[WARN] all SNAT Rules of %s are gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("rules", rules),
+		d.Set("cidrs", cidrs),
+		d.Set("network_ids", networkIDs),
+		d.Set("floating_ip_ids", floatingIPs),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceNatSnatRulesV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationClient, err)
+	}
+
+	var (
+		cidrsToCreate, networksToCreate []string
+		keep                            []string
+	)
+	oldCidrs, newCidrs := d.GetChange("cidrs")
+	oldNetworks, newNetworks := d.GetChange("network_ids")
+	if d.HasChange("floating_ip_ids") {
+		// EIPs of the existing rules can't be changed, all the rules are recreated
+		for _, id := range stateSnatRuleIDs(d) {
+			if err := deleteSnatRule(ctx, client, id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		cidrsToCreate = common.ExpandToStringSlice(newCidrs.(*schema.Set).List())
+		networksToCreate = common.ExpandToStringSlice(newNetworks.(*schema.Set).List())
+	} else {
+		removedCidrs := oldCidrs.(*schema.Set).Difference(newCidrs.(*schema.Set))
+		removedNetworks := oldNetworks.(*schema.Set).Difference(newNetworks.(*schema.Set))
+		for _, raw := range d.Get("rules").([]interface{}) {
+			rule := raw.(map[string]interface{})
+			id := rule["id"].(string)
+			removed := removedCidrs.Contains(rule["cidr"])
+			if networkID := rule["network_id"].(string); networkID != "" {
+				removed = removedNetworks.Contains(networkID)
+			}
+			if removed {
+				if err := deleteSnatRule(ctx, client, id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.FromErr(err)
+				}
+				continue
+			}
+			keep = append(keep, id)
+		}
+		cidrsToCreate = common.ExpandToStringSlice(newCidrs.(*schema.Set).Difference(oldCidrs.(*schema.Set)).List())
+		networksToCreate = common.ExpandToStringSlice(newNetworks.(*schema.Set).Difference(oldNetworks.(*schema.Set)).List())
+	}
+
+	created, err := createSnatRules(ctx, client, d, cidrsToCreate, networksToCreate, d.Timeout(schema.TimeoutUpdate))
+	if err := d.Set("rules", snatRuleIDsToList(append(keep, created...))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNatSnatRulesV2Read(ctx, d, meta)
+}
+
+func resourceNatSnatRulesV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreationClient, err)
+	}
+
+	for _, id := range stateSnatRuleIDs(d) {
+		if err := deleteSnatRule(ctx, client, id, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// createSnatRules creates the rules one by one, IDs of the created rules are
+// returned even in case of the error, so they can be saved to the state
+func createSnatRules(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, cidrs, networkIDs []string, timeout time.Duration) ([]string, error) {
+	floatingIPIDs := common.ExpandToStringSlice(d.Get("floating_ip_ids").(*schema.Set).List())
+	sort.Strings(floatingIPIDs)
+
+	var opts []snatrules.CreateOpts
+	for _, cidr := range cidrs {
+		opts = append(opts, snatrules.CreateOpts{Cidr: cidr})
+	}
+	for _, networkID := range networkIDs {
+		opts = append(opts, snatrules.CreateOpts{NetworkID: networkID})
+	}
+
+	var ids []string
+	for _, createOpts := range opts {
+		createOpts.NatGatewayID = d.Get("nat_gateway_id").(string)
+		createOpts.FloatingIPID = strings.Join(floatingIPIDs, ",")
+		createOpts.SourceType = d.Get("source_type").(int)
+
+		log.Printf("[DEBUG] Create Options: %#v", createOpts)
+		rule, err := snatrules.Create(client, createOpts)
+		if err != nil {
+			return ids, fmt.Errorf("error creating SNAT Rule: %w", err)
+		}
+		ids = append(ids, rule.ID)
+
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"ACTIVE"},
+			Refresh:    waitForSnatRuleActive(client, rule.ID),
+			Timeout:    timeout,
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return ids, fmt.Errorf("error waiting for SNAT Rule %s to become active: %w", rule.ID, err)
+		}
+	}
+	return ids, nil
+}
+
+func deleteSnatRule(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForSnatRuleDelete(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error deleting SNAT Rule %s: %w", id, err)
+	}
+	return nil
+}
+
+func stateSnatRuleIDs(d *schema.ResourceData) []string {
+	var ids []string
+	for _, raw := range d.Get("rules").([]interface{}) {
+		ids = append(ids, raw.(map[string]interface{})["id"].(string))
+	}
+	return ids
+}
+
+func snatRuleIDsToList(ids []string) []map[string]interface{} {
+	rules := make([]map[string]interface{}, len(ids))
+	for i, id := range ids {
+		rules[i] = map[string]interface{}{"id": id}
+	}
+	return rules
+}
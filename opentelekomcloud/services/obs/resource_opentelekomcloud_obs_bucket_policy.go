@@ -32,7 +32,7 @@ func ResourceObsBucketPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateFunc:     common.ValidateJsonString,
-				DiffSuppressFunc: common.SuppressEquivalentAwsPolicyDiffs,
+				DiffSuppressFunc: common.SuppressEquivalentPolicyDiffs,
 			},
 		},
 	}
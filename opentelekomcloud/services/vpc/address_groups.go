@@ -0,0 +1,201 @@
+package vpc
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// The VPC v3 IP address group and security group rule APIs are not covered by gophertelekomcloud yet.
+
+type AddressGroupOpts struct {
+	// Address group name, up to 64 characters.
+	Name string `json:"name,omitempty"`
+	// Address group description, up to 255 characters.
+	Description *string `json:"description,omitempty"`
+	// IP version of the group: 4 or 6. Can't be updated.
+	IpVersion int `json:"ip_version,omitempty"`
+	// IP addresses, ranges (`10.0.0.1-10.0.0.10`) or CIDRs of the group.
+	IpSet []string `json:"ip_set"`
+	// Maximum number of entries in the group.
+	MaxCapacity int `json:"max_capacity,omitempty"`
+}
+
+type AddressGroup struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	IpVersion   int      `json:"ip_version"`
+	IpSet       []string `json:"ip_set"`
+	MaxCapacity int      `json:"max_capacity"`
+	Status      string   `json:"status"`
+	ProjectID   string   `json:"tenant_id"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+func CreateAddressGroup(client *golangsdk.ServiceClient, opts AddressGroupOpts) (*AddressGroup, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "address_group")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v3/{project_id}/vpc/address-groups
+	var res struct {
+		AddressGroup AddressGroup `json:"address_group"`
+	}
+	_, err = client.Post(client.ServiceURL("address-groups"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return &res.AddressGroup, err
+}
+
+func GetAddressGroup(client *golangsdk.ServiceClient, id string) (*AddressGroup, error) {
+	// GET /v3/{project_id}/vpc/address-groups/{address_group_id}
+	var res struct {
+		AddressGroup AddressGroup `json:"address_group"`
+	}
+	_, err := client.Get(client.ServiceURL("address-groups", id), &res, nil)
+	return &res.AddressGroup, err
+}
+
+type ListAddressGroupOpts struct {
+	ID        string `q:"id"`
+	Name      string `q:"name"`
+	IpVersion int    `q:"ip_version"`
+	Limit     int    `q:"limit"`
+	Marker    string `q:"marker"`
+}
+
+type pageInfo struct {
+	NextMarker string `json:"next_marker"`
+}
+
+func ListAddressGroups(client *golangsdk.ServiceClient, opts ListAddressGroupOpts) ([]AddressGroup, error) {
+	var groups []AddressGroup
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("address-groups").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v3/{project_id}/vpc/address-groups
+		var res struct {
+			AddressGroups []AddressGroup `json:"address_groups"`
+			PageInfo      pageInfo       `json:"page_info"`
+		}
+		_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, res.AddressGroups...)
+		if res.PageInfo.NextMarker == "" {
+			return groups, nil
+		}
+		opts.Marker = res.PageInfo.NextMarker
+	}
+}
+
+func UpdateAddressGroup(client *golangsdk.ServiceClient, id string, opts AddressGroupOpts) (*AddressGroup, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "address_group")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT /v3/{project_id}/vpc/address-groups/{address_group_id}
+	var res struct {
+		AddressGroup AddressGroup `json:"address_group"`
+	}
+	_, err = client.Put(client.ServiceURL("address-groups", id), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return &res.AddressGroup, err
+}
+
+func DeleteAddressGroup(client *golangsdk.ServiceClient, id string) error {
+	// DELETE /v3/{project_id}/vpc/address-groups/{address_group_id}
+	_, err := client.Delete(client.ServiceURL("address-groups", id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+// SecurityGroupRuleV3Opts creates the security group rule referencing an IP address group,
+// which isn't supported by the Neutron API. The rule can be read and deleted by the Neutron API afterwards.
+type SecurityGroupRuleV3Opts struct {
+	SecurityGroupID      string `json:"security_group_id"`
+	Description          string `json:"description,omitempty"`
+	Direction            string `json:"direction"`
+	EtherType            string `json:"ethertype,omitempty"`
+	Protocol             string `json:"protocol,omitempty"`
+	Multiport            string `json:"multiport,omitempty"`
+	RemoteAddressGroupID string `json:"remote_address_group_id"`
+}
+
+type SecurityGroupRuleV3 struct {
+	ID                   string `json:"id"`
+	SecurityGroupID      string `json:"security_group_id"`
+	Direction            string `json:"direction"`
+	Protocol             string `json:"protocol"`
+	Multiport            string `json:"multiport"`
+	RemoteAddressGroupID string `json:"remote_address_group_id"`
+}
+
+func CreateSecurityGroupRuleV3(client *golangsdk.ServiceClient, opts SecurityGroupRuleV3Opts) (*SecurityGroupRuleV3, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "security_group_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v3/{project_id}/vpc/security-group-rules
+	var res struct {
+		SecurityGroupRule SecurityGroupRuleV3 `json:"security_group_rule"`
+	}
+	_, err = client.Post(client.ServiceURL("security-group-rules"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return &res.SecurityGroupRule, err
+}
+
+func GetSecurityGroupRuleV3(client *golangsdk.ServiceClient, id string) (*SecurityGroupRuleV3, error) {
+	// GET /v3/{project_id}/vpc/security-group-rules/{security_group_rule_id}
+	var res struct {
+		SecurityGroupRule SecurityGroupRuleV3 `json:"security_group_rule"`
+	}
+	_, err := client.Get(client.ServiceURL("security-group-rules", id), &res, nil)
+	return &res.SecurityGroupRule, err
+}
+
+type listSecurityGroupRuleV3Opts struct {
+	SecurityGroupID string `q:"security_group_id"`
+	Limit           int    `q:"limit"`
+	Marker          string `q:"marker"`
+}
+
+func ListSecurityGroupRulesV3(client *golangsdk.ServiceClient, securityGroupID string) ([]SecurityGroupRuleV3, error) {
+	var rules []SecurityGroupRuleV3
+	opts := listSecurityGroupRuleV3Opts{SecurityGroupID: securityGroupID, Limit: 2000}
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("security-group-rules").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v3/{project_id}/vpc/security-group-rules
+		var res struct {
+			SecurityGroupRules []SecurityGroupRuleV3 `json:"security_group_rules"`
+			PageInfo           pageInfo              `json:"page_info"`
+		}
+		_, err = client.Get(client.ServiceURL(url.String()), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, res.SecurityGroupRules...)
+		if res.PageInfo.NextMarker == "" {
+			return rules, nil
+		}
+		opts.Marker = res.PageInfo.NextMarker
+	}
+}
@@ -4,6 +4,7 @@ const (
 	errCreationV3Client = "error creating OpenTelekomCloud NetworkingV3 client: %w"
 	errCreationV2Client = "error creating OpenTelekomCloud NetworkingV2 client: %w"
 	errCreationV1Client = "error creating OpenTelekomCloud NetworkingV1 client: %w"
+	keyClientV3         = "vpc-v3-client"
 	keyClientV2         = "vpc-v2-client"
 	keyClientV1         = "vpc-v1-client"
 	// MaxCreateRoutes is the limitation of creating API
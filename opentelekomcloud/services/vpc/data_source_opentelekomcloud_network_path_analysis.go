@@ -0,0 +1,568 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/er/v3/instance"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/er/v3/route"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/routetables"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/vpcs"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/dnatrules"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/firewall_groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/routerinsertion"
+	fwrules "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/rules"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/snatrules"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
+	VpcV3 "github.com/opentelekomcloud/gophertelekomcloud/openstack/vpc/v3/vpcs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceNetworkPathAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkPathAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_port_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"source_port_id", "source_ip"},
+			},
+			"source_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"destination_ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "tcp",
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp", "any"}, false),
+			},
+			"reachable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"hops": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"detail": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkPathAnalysisRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+
+	loader := &pathNetworkLoader{
+		config: config,
+		region: region,
+		network: PathNetwork{
+			Endpoints:      map[string]*PathEndpoint{},
+			VPCs:           map[string]PathVPC{},
+			SecurityGroups: map[string]PathSecurityGroup{},
+			AddressGroups:  map[string][]string{},
+			Firewalls:      map[string]PathFirewallGroup{},
+			ERRouteTables:  map[string]PathERRouteTable{},
+		},
+	}
+	var err error
+	if loader.v1Client, err = config.NetworkingV1Client(region); err != nil {
+		return fmterr.Errorf(errCreationV1Client, err)
+	}
+	if loader.v2Client, err = config.NetworkingV2Client(region); err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+	if loader.v3Client, err = config.NetworkingV3Client(region); err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	packet := PathPacket{
+		SrcIP:    d.Get("source_ip").(string),
+		DstIP:    d.Get("destination_ip").(string),
+		Protocol: d.Get("protocol").(string),
+		DstPort:  d.Get("destination_port").(int),
+	}
+
+	var srcPort *ports.Port
+	if portID := d.Get("source_port_id").(string); portID != "" {
+		srcPort, err = ports.Get(loader.v2Client, portID).Extract()
+		if err != nil {
+			return fmterr.Errorf("error retrieving source port %s: %w", portID, err)
+		}
+		if len(srcPort.FixedIPs) == 0 {
+			return fmterr.Errorf("source port %s has no fixed IP", portID)
+		}
+		packet.SrcIP = srcPort.FixedIPs[0].IPAddress
+	} else {
+		srcPort, err = loader.portByIP(packet.SrcIP)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if srcPort == nil {
+			return fmterr.Errorf("no port with the fixed IP %s found", packet.SrcIP)
+		}
+	}
+
+	if err := loader.loadNAT(); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := loader.addEndpoint(srcPort, packet.SrcIP); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the destination can be behind DNAT or outside the project
+	dstIP := loader.network.translateDNAT(packet)
+	if _, ok := loader.network.Endpoints[dstIP]; !ok {
+		dstPort, err := loader.portByIP(dstIP)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if dstPort != nil {
+			if err := loader.addEndpoint(dstPort, dstIP); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if err := loader.loadFirewalls(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := AnalyzePath(loader.network, packet)
+
+	hops := make([]interface{}, 0, len(result.Hops))
+	for _, hop := range result.Hops {
+		hops = append(hops, map[string]interface{}{
+			"component":   hop.Component,
+			"resource_id": hop.ResourceID,
+			"decision":    hop.Decision,
+			"rule_id":     hop.RuleID,
+			"detail":      hop.Detail,
+		})
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(
+		fmt.Sprintf("%s/%s/%s/%d", packet.SrcIP, packet.DstIP, packet.Protocol, packet.DstPort),
+	)))
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("source_port_id", srcPort.ID),
+		d.Set("source_ip", packet.SrcIP),
+		d.Set("reachable", result.Reachable),
+		d.Set("hops", hops),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// pathNetworkLoader reads the resources taking part in the path into PathNetwork
+type pathNetworkLoader struct {
+	config   *cfg.Config
+	region   string
+	v1Client *golangsdk.ServiceClient
+	v2Client *golangsdk.ServiceClient
+	v3Client *golangsdk.ServiceClient
+	network  PathNetwork
+}
+
+type portFixedIPListOpts struct {
+	IP string
+}
+
+func (opts portFixedIPListOpts) ToPortListQuery() (string, error) {
+	return "?fixed_ips=" + url.QueryEscape("ip_address="+opts.IP), nil
+}
+
+func (l *pathNetworkLoader) portByIP(ip string) (*ports.Port, error) {
+	pages, err := ports.List(l.v2Client, portFixedIPListOpts{IP: ip}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing ports with the fixed IP %s: %w", ip, err)
+	}
+	found, err := ports.ExtractPorts(pages)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+	return &found[0], nil
+}
+
+func (l *pathNetworkLoader) addEndpoint(port *ports.Port, ip string) error {
+	subnet, err := subnets.Get(l.v1Client, port.NetworkID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving subnet %s of the port %s: %w", port.NetworkID, port.ID, err)
+	}
+	endpoint := &PathEndpoint{
+		IP:               ip,
+		PortID:           port.ID,
+		VpcID:            subnet.VpcID,
+		SubnetID:         subnet.ID,
+		SecurityGroupIDs: port.SecurityGroups,
+	}
+
+	pages, err := floatingips.List(l.v2Client, floatingips.ListOpts{PortID: port.ID}).AllPages()
+	if err != nil {
+		return fmt.Errorf("error listing EIPs of the port %s: %w", port.ID, err)
+	}
+	eips, err := floatingips.ExtractFloatingIPs(pages)
+	if err != nil {
+		return err
+	}
+	if len(eips) > 0 {
+		endpoint.EIP = eips[0].FloatingIP
+	}
+	l.network.Endpoints[ip] = endpoint
+
+	for _, groupID := range port.SecurityGroups {
+		if err := l.loadSecurityGroup(groupID); err != nil {
+			return err
+		}
+	}
+	return l.loadVPC(subnet.VpcID)
+}
+
+func (l *pathNetworkLoader) loadSecurityGroup(id string) error {
+	if _, ok := l.network.SecurityGroups[id]; ok {
+		return nil
+	}
+	group, err := groups.Get(l.v2Client, id).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving security group %s: %w", id, err)
+	}
+	// the v2 API doesn't return address groups of the rules, such rules would look like allowing any remote
+	v3Rules, err := ListSecurityGroupRulesV3(l.v3Client, id)
+	if err != nil {
+		return fmt.Errorf("error listing rules of the security group %s: %w", id, err)
+	}
+	addressGroups := make(map[string]string)
+	for _, rule := range v3Rules {
+		if rule.RemoteAddressGroupID != "" {
+			addressGroups[rule.ID] = rule.RemoteAddressGroupID
+		}
+	}
+
+	pathGroup := PathSecurityGroup{ID: group.ID}
+	for _, rule := range group.Rules {
+		pathRule := PathSecurityGroupRule{
+			ID:                   rule.ID,
+			Direction:            rule.Direction,
+			EtherType:            rule.EtherType,
+			Protocol:             rule.Protocol,
+			RemoteIPPrefix:       rule.RemoteIPPrefix,
+			RemoteGroupID:        rule.RemoteGroupID,
+			RemoteAddressGroupID: addressGroups[rule.ID],
+		}
+		if pathRule.RemoteAddressGroupID != "" {
+			l.loadAddressGroup(pathRule.RemoteAddressGroupID)
+		}
+		if rule.PortRangeMin != nil {
+			pathRule.PortRangeMin = *rule.PortRangeMin
+		}
+		if rule.PortRangeMax != nil {
+			pathRule.PortRangeMax = *rule.PortRangeMax
+		}
+		pathGroup.Rules = append(pathGroup.Rules, pathRule)
+	}
+	l.network.SecurityGroups[id] = pathGroup
+	return nil
+}
+
+// loadAddressGroup doesn't fail the analysis, the rule with the unresolved address group doesn't allow any traffic
+func (l *pathNetworkLoader) loadAddressGroup(id string) {
+	if _, ok := l.network.AddressGroups[id]; ok {
+		return
+	}
+	group, err := GetAddressGroup(l.v3Client, id)
+	if err != nil {
+		log.Printf("[WARN] Error retrieving address group %s, its rules are treated as not allowing traffic: %s", id, err)
+		return
+	}
+	l.network.AddressGroups[id] = group.IpSet
+}
+
+func (l *pathNetworkLoader) loadVPC(id string) error {
+	if _, ok := l.network.VPCs[id]; ok {
+		return nil
+	}
+	vpc, err := vpcs.Get(l.v1Client, id).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving VPC %s: %w", id, err)
+	}
+	// secondary CIDRs are returned only by the v3 API
+	vpcV3, err := VpcV3.Get(l.v3Client, id)
+	if err != nil {
+		return fmt.Errorf("error retrieving secondary CIDRs of the VPC %s: %w", id, err)
+	}
+	l.network.VPCs[id] = PathVPC{ID: vpc.ID, CIDRs: append([]string{vpc.CIDR}, vpcV3.SecondaryCidrs...)}
+
+	tables, err := routetables.List(l.v1Client, routetables.ListOpts{VpcID: id})
+	if err != nil {
+		return fmt.Errorf("error listing route tables of the VPC %s: %w", id, err)
+	}
+	for _, table := range tables {
+		pathTable := PathRouteTable{
+			ID:      table.ID,
+			VpcID:   table.VpcID,
+			Default: table.Default,
+		}
+		for _, subnet := range table.Subnets {
+			pathTable.SubnetIDs = append(pathTable.SubnetIDs, subnet.ID)
+		}
+		for _, r := range table.Routes {
+			pathTable.Routes = append(pathTable.Routes, PathRoute{
+				Destination: r.DestinationCIDR,
+				Type:        r.Type,
+				NextHop:     r.NextHop,
+			})
+			if r.Type == "er" {
+				if err := l.loadERRouteTable(r.NextHop); err != nil {
+					return err
+				}
+			}
+		}
+		l.network.RouteTables = append(l.network.RouteTables, pathTable)
+	}
+	return nil
+}
+
+// loadERRouteTable reads the effective routes of the default association route table of the enterprise router
+func (l *pathNetworkLoader) loadERRouteTable(erID string) error {
+	if _, ok := l.network.ERRouteTables[erID]; ok {
+		return nil
+	}
+	client, err := l.config.ErV3Client(l.region)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud EnterpriseRouter v3 client: %w", err)
+	}
+	er, err := instance.Get(client, erID)
+	if err != nil {
+		return fmt.Errorf("error retrieving enterprise router %s: %w", erID, err)
+	}
+	tableID := er.Instance.DefaultAssociationRouteTableID
+	pathTable := PathERRouteTable{ID: tableID}
+	opts := route.ListOpts{RouteTableId: tableID}
+	for tableID != "" {
+		routes, err := route.List(client, opts)
+		if err != nil {
+			return fmt.Errorf("error listing routes of the enterprise router route table %s: %w", tableID, err)
+		}
+		for _, r := range routes.Routes {
+			pathRoute := PathERRoute{
+				ID:          r.RouteId,
+				Destination: r.Destination,
+				Blackhole:   r.IsBlackhole,
+			}
+			if len(r.NextHops) > 0 {
+				pathRoute.ResourceID = r.NextHops[0].ResourceId
+			}
+			pathTable.Routes = append(pathTable.Routes, pathRoute)
+		}
+		if routes.PageInfo == nil || routes.PageInfo.NextMarker == "" {
+			break
+		}
+		opts.Marker = routes.PageInfo.NextMarker
+	}
+	l.network.ERRouteTables[erID] = pathTable
+	return nil
+}
+
+func (l *pathNetworkLoader) loadNAT() error {
+	client, err := l.config.NatV2Client(l.region)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NAT v2 client: %w", err)
+	}
+	snats, err := snatrules.List(client, snatrules.ListOpts{})
+	if err != nil {
+		return fmt.Errorf("error listing SNAT rules: %w", err)
+	}
+	for _, rule := range snats {
+		l.network.SNATRules = append(l.network.SNATRules, PathSNATRule{
+			ID:         rule.ID,
+			GatewayID:  rule.NatGatewayID,
+			SubnetID:   rule.NetworkID,
+			CIDR:       rule.Cidr,
+			FloatingIP: rule.FloatingIPAddress,
+		})
+	}
+
+	dnats, err := dnatrules.List(client, dnatrules.ListOpts{})
+	if err != nil {
+		return fmt.Errorf("error listing DNAT rules: %w", err)
+	}
+	for _, rule := range dnats {
+		internalIP := rule.PrivateIp
+		if internalIP == "" && rule.PortId != "" {
+			port, err := ports.Get(l.v2Client, rule.PortId).Extract()
+			if err != nil {
+				return fmt.Errorf("error retrieving port %s of the DNAT rule %s: %w", rule.PortId, rule.ID, err)
+			}
+			if len(port.FixedIPs) > 0 {
+				internalIP = port.FixedIPs[0].IPAddress
+			}
+		}
+		l.network.DNATRules = append(l.network.DNATRules, PathDNATRule{
+			ID:           rule.ID,
+			GatewayID:    rule.NatGatewayId,
+			Protocol:     rule.Protocol,
+			FloatingIP:   rule.FloatingIpAddress,
+			ExternalPort: rule.ExternalServicePort,
+			InternalIP:   internalIP,
+			InternalPort: rule.InternalServicePort,
+		})
+	}
+	return nil
+}
+
+type pathFirewallGroup struct {
+	firewall_groups.FirewallGroup
+	routerinsertion.FirewallGroupExt
+}
+
+// loadFirewalls reads the firewall groups bound to the subnets of the endpoints
+func (l *pathNetworkLoader) loadFirewalls() error {
+	subnetIDs := make(map[string]bool)
+	for _, endpoint := range l.network.Endpoints {
+		subnetIDs[endpoint.SubnetID] = true
+	}
+
+	pages, err := firewall_groups.List(l.v2Client, firewall_groups.ListOpts{}).AllPages()
+	if err != nil {
+		return fmt.Errorf("error listing firewall groups: %w", err)
+	}
+	var fwGroups []pathFirewallGroup
+	if err := firewall_groups.ExtractFirewallGroupsInto(pages, &fwGroups); err != nil {
+		return err
+	}
+
+	var rulesByID map[string]fwrules.Rule
+	for _, fw := range fwGroups {
+		if !fw.AdminStateUp {
+			continue
+		}
+		for _, portID := range fw.PortIDs {
+			port, err := ports.Get(l.v2Client, portID).Extract()
+			if err != nil {
+				return fmt.Errorf("error retrieving port %s of the firewall group %s: %w", portID, fw.ID, err)
+			}
+			if !subnetIDs[port.NetworkID] {
+				continue
+			}
+			if rulesByID == nil {
+				if rulesByID, err = l.listFirewallRules(); err != nil {
+					return err
+				}
+			}
+			pathGroup := PathFirewallGroup{ID: fw.ID}
+			if pathGroup.IngressRules, err = l.firewallPolicyRules(fw.IngressPolicyID, rulesByID); err != nil {
+				return err
+			}
+			if pathGroup.EgressRules, err = l.firewallPolicyRules(fw.EgressPolicyID, rulesByID); err != nil {
+				return err
+			}
+			l.network.Firewalls[port.NetworkID] = pathGroup
+		}
+	}
+	return nil
+}
+
+func (l *pathNetworkLoader) listFirewallRules() (map[string]fwrules.Rule, error) {
+	pages, err := fwrules.List(l.v2Client, fwrules.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing firewall rules: %w", err)
+	}
+	all, err := fwrules.ExtractRules(pages)
+	if err != nil {
+		return nil, err
+	}
+	rulesByID := make(map[string]fwrules.Rule, len(all))
+	for _, rule := range all {
+		rulesByID[rule.ID] = rule
+	}
+	return rulesByID, nil
+}
+
+// firewallPolicyRules returns the rules in the policy order, nil is returned if the policy isn't set
+func (l *pathNetworkLoader) firewallPolicyRules(policyID string, rulesByID map[string]fwrules.Rule) ([]PathFirewallRule, error) {
+	if policyID == "" {
+		return nil, nil
+	}
+	policy, err := policies.Get(l.v2Client, policyID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving firewall policy %s: %w", policyID, err)
+	}
+	pathRules := make([]PathFirewallRule, 0, len(policy.Rules))
+	for _, ruleID := range policy.Rules {
+		rule, ok := rulesByID[ruleID]
+		if !ok {
+			continue
+		}
+		pathRules = append(pathRules, PathFirewallRule{
+			ID:                   rule.ID,
+			Action:               rule.Action,
+			Protocol:             rule.Protocol,
+			SourceIPAddress:      rule.SourceIPAddress,
+			DestinationIPAddress: rule.DestinationIPAddress,
+			DestinationPort:      rule.DestinationPort,
+			Enabled:              rule.Enabled,
+		})
+	}
+	return pathRules, nil
+}
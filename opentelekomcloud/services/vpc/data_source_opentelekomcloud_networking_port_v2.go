@@ -96,6 +96,12 @@ func DataSourceNetworkingPortV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"all_fixed_ipv6s": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"all_security_group_ids": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -230,6 +236,7 @@ func dataSourceNetworkingPortV2Read(_ context.Context, d *schema.ResourceData, m
 		d.Set("region", config.GetRegion(d)),
 		d.Set("all_security_group_ids", port.SecurityGroups),
 		d.Set("all_fixed_ips", expandNetworkingPortFixedIPToStringSlice(port.FixedIPs)),
+		d.Set("all_fixed_ipv6s", filterIPv6Addresses(expandNetworkingPortFixedIPToStringSlice(port.FixedIPs))),
 	)
 
 	if err := mErr.ErrorOrNil(); err != nil {
@@ -247,3 +254,14 @@ func expandNetworkingPortFixedIPToStringSlice(fixedIPs []ports.IP) []string {
 
 	return s
 }
+
+// filterIPv6Addresses returns IPv6 addresses of the list keeping the order
+func filterIPv6Addresses(ips []string) []string {
+	var ipv6 []string
+	for _, ip := range ips {
+		if isIPv6(ip) {
+			ipv6 = append(ipv6, ip)
+		}
+	}
+	return ipv6
+}
@@ -0,0 +1,100 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceVpcAddressGroupV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpcAddressGroupV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ip_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVpcAddressGroupV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	groups, err := ListAddressGroups(client, ListAddressGroupOpts{
+		ID:        d.Get("id").(string),
+		Name:      d.Get("name").(string),
+		IpVersion: d.Get("ip_version").(int),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud VPC address groups: %w", err)
+	}
+	if len(groups) < 1 {
+		return fmterr.Errorf("your query returned no results. Please change your search criteria and try again")
+	}
+	if len(groups) > 1 {
+		return fmterr.Errorf("your query returned more than one result. Please try a more specific search criteria")
+	}
+
+	group := groups[0]
+	d.SetId(group.ID)
+
+	mErr := multierror.Append(
+		d.Set("name", group.Name),
+		d.Set("description", group.Description),
+		d.Set("ip_version", group.IpVersion),
+		d.Set("addresses", group.IpSet),
+		d.Set("max_capacity", group.MaxCapacity),
+		d.Set("status", group.Status),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting address group fields: %w", err))
+	}
+
+	return nil
+}
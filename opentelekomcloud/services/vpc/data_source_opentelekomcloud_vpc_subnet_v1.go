@@ -84,6 +84,10 @@ func DataSourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ipv6_subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"subnet_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -151,6 +155,13 @@ func dataSourceVpcSubnetV1Read(_ context.Context, d *schema.ResourceData, meta i
 		d.Set("gateway_ipv6", subnet.GatewayIpV6),
 		d.Set("region", config.GetRegion(d)),
 	)
+	if subnet.EnableIpv6 {
+		ipv6SubnetID, err := getIPv6SubnetID(config, config.GetRegion(d), subnet.NetworkID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		mErr = multierror.Append(mErr, d.Set("ipv6_subnet_id", ipv6SubnetID))
+	}
 	if mErr.ErrorOrNil() != nil {
 		return diag.FromErr(mErr)
 	}
@@ -0,0 +1,669 @@
+package vpc
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// The path analysis evaluates the network configuration offline: the data source loads
+// the resources into PathNetwork and the evaluation doesn't call any API.
+
+const (
+	pathDecisionAllow   = "allow"
+	pathDecisionDeny    = "deny"
+	pathDecisionForward = "forward"
+	pathDecisionNAT     = "nat"
+
+	pathComponentSecurityGroup = "security_group"
+	pathComponentFirewall      = "firewall"
+	pathComponentVPC           = "vpc"
+	pathComponentRouteTable    = "route_table"
+	pathComponentSNAT          = "snat"
+	pathComponentDNAT          = "dnat"
+	pathComponentER            = "enterprise_router"
+	pathComponentEIP           = "eip"
+	pathComponentDestination   = "destination"
+)
+
+// PathPacket is the traffic to be analyzed. Empty protocol or `any` matches every protocol,
+// zero port means the port isn't checked.
+type PathPacket struct {
+	SrcIP    string
+	DstIP    string
+	Protocol string
+	DstPort  int
+}
+
+// PathEndpoint is a port taking part in the analysis.
+type PathEndpoint struct {
+	IP               string
+	PortID           string
+	VpcID            string
+	SubnetID         string
+	SecurityGroupIDs []string
+	// EIP is the address of the EIP bound to the port, if any.
+	EIP string
+}
+
+type PathSecurityGroupRule struct {
+	ID             string
+	Direction      string
+	EtherType      string
+	Protocol       string
+	PortRangeMin   int
+	PortRangeMax   int
+	RemoteIPPrefix string
+	RemoteGroupID  string
+	// RemoteAddressGroupID is the IP address group of the rule, the addresses are in PathNetwork.AddressGroups.
+	RemoteAddressGroupID string
+}
+
+type PathSecurityGroup struct {
+	ID    string
+	Rules []PathSecurityGroupRule
+}
+
+// PathFirewallRule is a rule of a firewall policy. Ports use the FWaaS `N` or `N:M` format.
+type PathFirewallRule struct {
+	ID                   string
+	Action               string
+	Protocol             string
+	SourceIPAddress      string
+	DestinationIPAddress string
+	DestinationPort      string
+	Enabled              bool
+}
+
+// PathFirewallGroup is a firewall group with the rules of its policies in the evaluation order.
+// Nil rule list means the direction has no policy and isn't filtered.
+type PathFirewallGroup struct {
+	ID           string
+	IngressRules []PathFirewallRule
+	EgressRules  []PathFirewallRule
+}
+
+type PathRoute struct {
+	Destination string
+	Type        string
+	NextHop     string
+}
+
+type PathRouteTable struct {
+	ID        string
+	VpcID     string
+	Default   bool
+	SubnetIDs []string
+	Routes    []PathRoute
+}
+
+type PathVPC struct {
+	ID    string
+	CIDRs []string
+}
+
+type PathSNATRule struct {
+	ID         string
+	GatewayID  string
+	SubnetID   string
+	CIDR       string
+	FloatingIP string
+}
+
+type PathDNATRule struct {
+	ID           string
+	GatewayID    string
+	Protocol     string
+	FloatingIP   string
+	ExternalPort int
+	InternalIP   string
+	InternalPort int
+}
+
+type PathERRoute struct {
+	ID          string
+	Destination string
+	Blackhole   bool
+	// ResourceID is the resource (e.g. VPC) of the next hop attachment.
+	ResourceID string
+}
+
+type PathERRouteTable struct {
+	ID     string
+	Routes []PathERRoute
+}
+
+// PathNetwork is the configuration the path is evaluated against.
+type PathNetwork struct {
+	// Endpoints are keyed by the fixed IP of the port.
+	Endpoints      map[string]*PathEndpoint
+	VPCs           map[string]PathVPC
+	SecurityGroups map[string]PathSecurityGroup
+	// AddressGroups are the IP sets of the address groups used by the security group rules.
+	// The rule referencing a missing address group doesn't allow any traffic.
+	AddressGroups map[string][]string
+	// Firewalls are keyed by the ID of the subnet the firewall group is bound to.
+	Firewalls   map[string]PathFirewallGroup
+	RouteTables []PathRouteTable
+	SNATRules   []PathSNATRule
+	DNATRules   []PathDNATRule
+	// ERRouteTables are keyed by the enterprise router ID used as the next hop of VPC routes.
+	ERRouteTables map[string]PathERRouteTable
+}
+
+type PathHop struct {
+	Component  string
+	ResourceID string
+	Decision   string
+	RuleID     string
+	Detail     string
+}
+
+type PathResult struct {
+	Reachable bool
+	Hops      []PathHop
+}
+
+// AnalyzePath evaluates the path of the packet from the source endpoint hop by hop,
+// stopping at the first component blocking the traffic.
+func AnalyzePath(network PathNetwork, packet PathPacket) PathResult {
+	res := PathResult{}
+	deny := func(hop PathHop) PathResult {
+		hop.Decision = pathDecisionDeny
+		res.Hops = append(res.Hops, hop)
+		return res
+	}
+
+	src := network.Endpoints[packet.SrcIP]
+	if src == nil {
+		return deny(PathHop{
+			Component: pathComponentDestination,
+			Detail:    fmt.Sprintf("source %s is not a port of the project", packet.SrcIP),
+		})
+	}
+
+	// source security groups, egress
+	hop, ok := evaluateSecurityGroups(network, src, "egress", packet.DstIP, network.Endpoints[packet.DstIP], packet)
+	if !ok {
+		return deny(hop)
+	}
+	res.Hops = append(res.Hops, hop)
+
+	// source firewall, egress
+	if fw, ok := network.Firewalls[src.SubnetID]; ok {
+		hop, ok := evaluateFirewall(fw, fw.EgressRules, packet.SrcIP, packet.DstIP, packet)
+		if !ok {
+			return deny(hop)
+		}
+		res.Hops = append(res.Hops, hop)
+	}
+
+	// routing
+	seenSrcIP := packet.SrcIP
+	dstVpcID := ""
+	if vpc, ok := network.VPCs[src.VpcID]; ok && ipInAny(packet.DstIP, vpc.CIDRs) {
+		dstVpcID = vpc.ID
+		res.Hops = append(res.Hops, PathHop{
+			Component:  pathComponentVPC,
+			ResourceID: vpc.ID,
+			Decision:   pathDecisionForward,
+			Detail:     fmt.Sprintf("%s is inside the VPC", packet.DstIP),
+		})
+	} else {
+		table := network.routeTableFor(src)
+		route, found := matchRoute(table, packet.DstIP)
+		switch {
+		case found && route.Type == "nat":
+			res.Hops = append(res.Hops, PathHop{
+				Component:  pathComponentRouteTable,
+				ResourceID: table.ID,
+				Decision:   pathDecisionForward,
+				RuleID:     route.Destination,
+				Detail:     fmt.Sprintf("next hop NAT gateway %s", route.NextHop),
+			})
+			snat, ok := network.matchSNAT(route.NextHop, src)
+			if !ok {
+				return deny(PathHop{
+					Component:  pathComponentSNAT,
+					ResourceID: route.NextHop,
+					Detail:     fmt.Sprintf("no SNAT rule of the gateway covers %s", packet.SrcIP),
+				})
+			}
+			seenSrcIP = snat.FloatingIP
+			res.Hops = append(res.Hops, PathHop{
+				Component:  pathComponentSNAT,
+				ResourceID: snat.GatewayID,
+				Decision:   pathDecisionNAT,
+				RuleID:     snat.ID,
+				Detail:     fmt.Sprintf("source translated to %s", snat.FloatingIP),
+			})
+		case found && route.Type == "er":
+			res.Hops = append(res.Hops, PathHop{
+				Component:  pathComponentRouteTable,
+				ResourceID: table.ID,
+				Decision:   pathDecisionForward,
+				RuleID:     route.Destination,
+				Detail:     fmt.Sprintf("next hop enterprise router %s", route.NextHop),
+			})
+			erTable, ok := network.ERRouteTables[route.NextHop]
+			if !ok {
+				return deny(PathHop{
+					Component:  pathComponentER,
+					ResourceID: route.NextHop,
+					Detail:     "route table of the enterprise router is unknown",
+				})
+			}
+			erRoute, ok := matchERRoute(erTable, packet.DstIP)
+			if !ok {
+				return deny(PathHop{
+					Component:  pathComponentER,
+					ResourceID: erTable.ID,
+					Detail:     fmt.Sprintf("no route to %s", packet.DstIP),
+				})
+			}
+			if erRoute.Blackhole {
+				return deny(PathHop{
+					Component:  pathComponentER,
+					ResourceID: erTable.ID,
+					RuleID:     erRoute.ID,
+					Detail:     fmt.Sprintf("blackhole route %s", erRoute.Destination),
+				})
+			}
+			dstVpcID = erRoute.ResourceID
+			res.Hops = append(res.Hops, PathHop{
+				Component:  pathComponentER,
+				ResourceID: erTable.ID,
+				Decision:   pathDecisionForward,
+				RuleID:     erRoute.ID,
+				Detail:     fmt.Sprintf("next hop %s", erRoute.ResourceID),
+			})
+		case found:
+			res.Hops = append(res.Hops, PathHop{
+				Component:  pathComponentRouteTable,
+				ResourceID: table.ID,
+				Decision:   pathDecisionForward,
+				RuleID:     route.Destination,
+				Detail:     fmt.Sprintf("next hop %s %s", route.Type, route.NextHop),
+			})
+		case src.EIP != "" && !isPrivateIP(packet.DstIP):
+			seenSrcIP = src.EIP
+			res.Hops = append(res.Hops, PathHop{
+				Component:  pathComponentEIP,
+				ResourceID: src.EIP,
+				Decision:   pathDecisionForward,
+				Detail:     fmt.Sprintf("source translated to %s", src.EIP),
+			})
+		case !isPrivateIP(packet.DstIP):
+			// public NAT gateways serve the subnets of the VPC without routes
+			snat, ok := network.matchSNAT("", src)
+			if !ok {
+				return deny(PathHop{
+					Component:  pathComponentRouteTable,
+					ResourceID: table.ID,
+					Detail:     fmt.Sprintf("no route to %s, the port has no EIP or SNAT rule", packet.DstIP),
+				})
+			}
+			seenSrcIP = snat.FloatingIP
+			res.Hops = append(res.Hops, PathHop{
+				Component:  pathComponentSNAT,
+				ResourceID: snat.GatewayID,
+				Decision:   pathDecisionNAT,
+				RuleID:     snat.ID,
+				Detail:     fmt.Sprintf("source translated to %s", snat.FloatingIP),
+			})
+		default:
+			return deny(PathHop{
+				Component:  pathComponentRouteTable,
+				ResourceID: table.ID,
+				Detail:     fmt.Sprintf("no route to %s", packet.DstIP),
+			})
+		}
+	}
+
+	// destination NAT
+	dstIP, dstPacket := packet.DstIP, packet
+	if dnat, ok := network.matchDNAT(packet); ok {
+		dstIP = dnat.InternalIP
+		dstPacket.DstIP = dnat.InternalIP
+		if dnat.InternalPort != 0 {
+			dstPacket.DstPort = dnat.InternalPort
+		}
+		res.Hops = append(res.Hops, PathHop{
+			Component:  pathComponentDNAT,
+			ResourceID: dnat.GatewayID,
+			Decision:   pathDecisionNAT,
+			RuleID:     dnat.ID,
+			Detail:     fmt.Sprintf("destination translated to %s", joinHostPort(dnat.InternalIP, dstPacket.DstPort)),
+		})
+	}
+
+	dst := network.Endpoints[dstIP]
+	if dst == nil || (dstVpcID != "" && dst.VpcID != dstVpcID) {
+		res.Hops = append(res.Hops, PathHop{
+			Component: pathComponentDestination,
+			Decision:  pathDecisionForward,
+			Detail:    fmt.Sprintf("%s is not a port of the project, ingress rules are not evaluated", dstIP),
+		})
+		res.Reachable = true
+		return res
+	}
+
+	// destination firewall, ingress
+	if fw, ok := network.Firewalls[dst.SubnetID]; ok {
+		hop, ok := evaluateFirewall(fw, fw.IngressRules, seenSrcIP, dstIP, dstPacket)
+		if !ok {
+			return deny(hop)
+		}
+		res.Hops = append(res.Hops, hop)
+	}
+
+	// destination security groups, ingress; remote groups match only untranslated traffic
+	var peer *PathEndpoint
+	if seenSrcIP == packet.SrcIP {
+		peer = src
+	}
+	hop, ok = evaluateSecurityGroups(network, dst, "ingress", seenSrcIP, peer, dstPacket)
+	if !ok {
+		return deny(hop)
+	}
+	res.Hops = append(res.Hops, hop)
+
+	res.Reachable = true
+	return res
+}
+
+// evaluateSecurityGroups checks the rules of all security groups of the endpoint,
+// the traffic is allowed if any rule matches.
+func evaluateSecurityGroups(network PathNetwork, endpoint *PathEndpoint, direction, remoteIP string, remote *PathEndpoint, packet PathPacket) (PathHop, bool) {
+	hop := PathHop{
+		Component:  pathComponentSecurityGroup,
+		ResourceID: strings.Join(endpoint.SecurityGroupIDs, ","),
+	}
+	etherType := "IPv4"
+	if isIPv6(remoteIP) {
+		etherType = "IPv6"
+	}
+	for _, groupID := range endpoint.SecurityGroupIDs {
+		group, ok := network.SecurityGroups[groupID]
+		if !ok {
+			continue
+		}
+		for _, rule := range group.Rules {
+			if rule.Direction != direction {
+				continue
+			}
+			if rule.EtherType != "" && !strings.EqualFold(rule.EtherType, etherType) {
+				continue
+			}
+			if !protocolMatches(rule.Protocol, packet.Protocol) {
+				continue
+			}
+			if !portInRange(packet, rule.PortRangeMin, rule.PortRangeMax) {
+				continue
+			}
+			switch {
+			case rule.RemoteAddressGroupID != "":
+				ipSet, ok := network.AddressGroups[rule.RemoteAddressGroupID]
+				if !ok || !ipInAddressSet(remoteIP, ipSet) {
+					continue
+				}
+			case rule.RemoteGroupID != "":
+				if remote == nil || !contains(remote.SecurityGroupIDs, rule.RemoteGroupID) {
+					continue
+				}
+			case rule.RemoteIPPrefix != "":
+				if !ipInCIDR(remoteIP, rule.RemoteIPPrefix) {
+					continue
+				}
+			}
+			hop.ResourceID = group.ID
+			hop.RuleID = rule.ID
+			hop.Decision = pathDecisionAllow
+			hop.Detail = fmt.Sprintf("%s rule allows %s", direction, remoteIP)
+			return hop, true
+		}
+	}
+	hop.Decision = pathDecisionDeny
+	hop.Detail = fmt.Sprintf("no %s rule allows %s", direction, remoteIP)
+	return hop, false
+}
+
+// evaluateFirewall applies the first matching enabled rule, the traffic not matching any rule is denied.
+func evaluateFirewall(fw PathFirewallGroup, rules []PathFirewallRule, srcIP, dstIP string, packet PathPacket) (PathHop, bool) {
+	hop := PathHop{
+		Component:  pathComponentFirewall,
+		ResourceID: fw.ID,
+	}
+	if rules == nil {
+		hop.Decision = pathDecisionAllow
+		hop.Detail = "no policy is set"
+		return hop, true
+	}
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+		if !protocolMatches(rule.Protocol, packet.Protocol) {
+			continue
+		}
+		if rule.SourceIPAddress != "" && !ipInCIDR(srcIP, rule.SourceIPAddress) {
+			continue
+		}
+		if rule.DestinationIPAddress != "" && !ipInCIDR(dstIP, rule.DestinationIPAddress) {
+			continue
+		}
+		if rule.DestinationPort != "" {
+			min, max, err := parseFirewallPorts(rule.DestinationPort)
+			if err != nil || !portInRange(packet, min, max) {
+				continue
+			}
+		}
+		hop.RuleID = rule.ID
+		if rule.Action == "allow" {
+			hop.Decision = pathDecisionAllow
+			hop.Detail = "rule allows the traffic"
+			return hop, true
+		}
+		hop.Decision = pathDecisionDeny
+		hop.Detail = fmt.Sprintf("rule action is %s", rule.Action)
+		return hop, false
+	}
+	hop.Decision = pathDecisionDeny
+	hop.Detail = "no rule matches, denied by default"
+	return hop, false
+}
+
+// routeTableFor returns the route table associated with the endpoint subnet or the VPC default one
+func (n PathNetwork) routeTableFor(endpoint *PathEndpoint) PathRouteTable {
+	var defaultTable PathRouteTable
+	for _, table := range n.RouteTables {
+		if table.VpcID != endpoint.VpcID {
+			continue
+		}
+		if contains(table.SubnetIDs, endpoint.SubnetID) {
+			return table
+		}
+		if table.Default {
+			defaultTable = table
+		}
+	}
+	return defaultTable
+}
+
+// matchSNAT returns the SNAT rule of the gateway covering the endpoint, any gateway is matched if the ID is empty
+func (n PathNetwork) matchSNAT(gatewayID string, endpoint *PathEndpoint) (PathSNATRule, bool) {
+	for _, rule := range n.SNATRules {
+		if gatewayID != "" && rule.GatewayID != gatewayID {
+			continue
+		}
+		if (rule.SubnetID != "" && rule.SubnetID == endpoint.SubnetID) ||
+			(rule.CIDR != "" && ipInCIDR(endpoint.IP, rule.CIDR)) {
+			return rule, true
+		}
+	}
+	return PathSNATRule{}, false
+}
+
+func (n PathNetwork) matchDNAT(packet PathPacket) (PathDNATRule, bool) {
+	for _, rule := range n.DNATRules {
+		if rule.FloatingIP != packet.DstIP || !protocolMatches(rule.Protocol, packet.Protocol) {
+			continue
+		}
+		if rule.ExternalPort != 0 && packet.DstPort != 0 && rule.ExternalPort != packet.DstPort {
+			continue
+		}
+		return rule, true
+	}
+	return PathDNATRule{}, false
+}
+
+// translateDNAT returns the internal address the packet is delivered to
+func (n PathNetwork) translateDNAT(packet PathPacket) string {
+	if rule, ok := n.matchDNAT(packet); ok {
+		return rule.InternalIP
+	}
+	return packet.DstIP
+}
+
+// matchRoute returns the route with the longest prefix matching the IP
+func matchRoute(table PathRouteTable, ip string) (PathRoute, bool) {
+	best, bestLen := PathRoute{}, -1
+	for _, route := range table.Routes {
+		if l := prefixLen(route.Destination, ip); l > bestLen {
+			best, bestLen = route, l
+		}
+	}
+	return best, bestLen >= 0
+}
+
+func matchERRoute(table PathERRouteTable, ip string) (PathERRoute, bool) {
+	best, bestLen := PathERRoute{}, -1
+	for _, route := range table.Routes {
+		if l := prefixLen(route.Destination, ip); l > bestLen {
+			best, bestLen = route, l
+		}
+	}
+	return best, bestLen >= 0
+}
+
+// prefixLen returns the prefix length of the CIDR containing the IP, -1 otherwise
+func prefixLen(cidr, ip string) int {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return -1
+	}
+	addr := net.ParseIP(ip)
+	if addr == nil || !network.Contains(addr) {
+		return -1
+	}
+	ones, _ := network.Mask.Size()
+	return ones
+}
+
+// ipInCIDR checks the IP against a CIDR or a single address
+func ipInCIDR(ip, cidr string) bool {
+	if !strings.Contains(cidr, "/") {
+		a, b := net.ParseIP(ip), net.ParseIP(cidr)
+		return a != nil && b != nil && a.Equal(b)
+	}
+	return prefixLen(cidr, ip) >= 0
+}
+
+func ipInAny(ip string, cidrs []string) bool {
+	for _, cidr := range cidrs {
+		if ipInCIDR(ip, cidr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ipInAddressSet checks the IP against the address group entries: single addresses, CIDRs or `first-last` ranges
+func ipInAddressSet(ip string, ipSet []string) bool {
+	addr := net.ParseIP(ip)
+	for _, entry := range ipSet {
+		first, last, found := strings.Cut(entry, "-")
+		if !found {
+			if ipInCIDR(ip, entry) {
+				return true
+			}
+			continue
+		}
+		from, to := net.ParseIP(strings.TrimSpace(first)), net.ParseIP(strings.TrimSpace(last))
+		if addr == nil || from == nil || to == nil || len(from.To4()) != len(addr.To4()) {
+			continue
+		}
+		if bytes.Compare(addr.To16(), from.To16()) >= 0 && bytes.Compare(addr.To16(), to.To16()) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func isIPv6(ip string) bool {
+	addr := net.ParseIP(ip)
+	return addr != nil && addr.To4() == nil
+}
+
+func isPrivateIP(ip string) bool {
+	addr := net.ParseIP(ip)
+	return addr != nil && (addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast())
+}
+
+func protocolMatches(ruleProtocol, protocol string) bool {
+	if ruleProtocol == "" || ruleProtocol == "any" {
+		return true
+	}
+	// the rule limited to a protocol doesn't allow all the traffic
+	if protocol == "" || protocol == "any" {
+		return false
+	}
+	return strings.EqualFold(ruleProtocol, protocol)
+}
+
+// portInRange checks the destination port of the TCP/UDP packet, zero range bounds match any port
+func portInRange(packet PathPacket, min, max int) bool {
+	if packet.DstPort == 0 || (min == 0 && max == 0) {
+		return true
+	}
+	if p := strings.ToLower(packet.Protocol); p != "tcp" && p != "udp" {
+		return true
+	}
+	if max == 0 {
+		max = min
+	}
+	return packet.DstPort >= min && packet.DstPort <= max
+}
+
+func parseFirewallPorts(ports string) (int, int, error) {
+	parts := strings.SplitN(ports, ":", 2)
+	min, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	max := min
+	if len(parts) == 2 {
+		if max, err = strconv.Atoi(parts[1]); err != nil {
+			return 0, 0, err
+		}
+	}
+	return min, max, nil
+}
+
+func joinHostPort(ip string, port int) string {
+	if port == 0 {
+		return ip
+	}
+	return net.JoinHostPort(ip, strconv.Itoa(port))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,286 @@
+package vpc
+
+import (
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const (
+	webIP  = "192.168.0.10"
+	dbIP   = "192.168.1.20"
+	peerIP = "172.16.0.5"
+)
+
+// pathFixture is a VPC with the web and db subnets, NAT gateway and enterprise router attachments
+func pathFixture() PathNetwork {
+	return PathNetwork{
+		Endpoints: map[string]*PathEndpoint{
+			webIP: {
+				IP:               webIP,
+				PortID:           "port-web",
+				VpcID:            "vpc-1",
+				SubnetID:         "subnet-web",
+				SecurityGroupIDs: []string{"sg-web"},
+			},
+			dbIP: {
+				IP:               dbIP,
+				PortID:           "port-db",
+				VpcID:            "vpc-1",
+				SubnetID:         "subnet-db",
+				SecurityGroupIDs: []string{"sg-db"},
+			},
+			peerIP: {
+				IP:               peerIP,
+				PortID:           "port-peer",
+				VpcID:            "vpc-2",
+				SubnetID:         "subnet-peer",
+				SecurityGroupIDs: []string{"sg-peer"},
+			},
+		},
+		VPCs: map[string]PathVPC{
+			"vpc-1": {ID: "vpc-1", CIDRs: []string{"192.168.0.0/16"}},
+			"vpc-2": {ID: "vpc-2", CIDRs: []string{"172.16.0.0/16"}},
+		},
+		SecurityGroups: map[string]PathSecurityGroup{
+			"sg-web": {ID: "sg-web", Rules: []PathSecurityGroupRule{
+				{ID: "web-egress", Direction: "egress", EtherType: "IPv4"},
+				{ID: "web-https", Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 443, PortRangeMax: 443, RemoteIPPrefix: "0.0.0.0/0"},
+			}},
+			"sg-db": {ID: "sg-db", Rules: []PathSecurityGroupRule{
+				{ID: "db-egress", Direction: "egress", EtherType: "IPv4"},
+				{ID: "db-postgres", Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 5432, PortRangeMax: 5432, RemoteGroupID: "sg-web"},
+			}},
+			"sg-peer": {ID: "sg-peer", Rules: []PathSecurityGroupRule{
+				{ID: "peer-ssh", Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "192.168.0.0/24"},
+			}},
+		},
+		RouteTables: []PathRouteTable{
+			{ID: "rtb-default", VpcID: "vpc-1", Default: true, SubnetIDs: []string{"subnet-db"}, Routes: []PathRoute{
+				{Destination: "0.0.0.0/0", Type: "nat", NextHop: "nat-1"},
+			}},
+			{ID: "rtb-web", VpcID: "vpc-1", SubnetIDs: []string{"subnet-web"}, Routes: []PathRoute{
+				{Destination: "0.0.0.0/0", Type: "nat", NextHop: "nat-1"},
+				{Destination: "172.16.0.0/12", Type: "er", NextHop: "er-1"},
+			}},
+		},
+		SNATRules: []PathSNATRule{
+			{ID: "snat-web", GatewayID: "nat-1", SubnetID: "subnet-web", FloatingIP: "80.158.1.1"},
+		},
+		DNATRules: []PathDNATRule{
+			{ID: "dnat-https", GatewayID: "nat-1", Protocol: "tcp", FloatingIP: "80.158.1.2", ExternalPort: 8443, InternalIP: webIP, InternalPort: 443},
+		},
+		ERRouteTables: map[string]PathERRouteTable{
+			"er-1": {ID: "er-rtb-1", Routes: []PathERRoute{
+				{ID: "er-route-peer", Destination: "172.16.0.0/16", ResourceID: "vpc-2"},
+				{ID: "er-route-blackhole", Destination: "172.16.66.0/24", Blackhole: true},
+			}},
+		},
+	}
+}
+
+func hopDecisions(res PathResult) []string {
+	var decisions []string
+	for _, hop := range res.Hops {
+		decisions = append(decisions, hop.Component+":"+hop.Decision)
+	}
+	return decisions
+}
+
+func lastHop(res PathResult) PathHop {
+	return res.Hops[len(res.Hops)-1]
+}
+
+func TestAnalyzePathSameVPC(t *testing.T) {
+	res := AnalyzePath(pathFixture(), PathPacket{SrcIP: webIP, DstIP: dbIP, Protocol: "tcp", DstPort: 5432})
+
+	th.AssertEquals(t, true, res.Reachable)
+	th.AssertDeepEquals(t, []string{
+		"security_group:allow",
+		"vpc:forward",
+		"security_group:allow",
+	}, hopDecisions(res))
+	th.AssertEquals(t, "db-postgres", lastHop(res).RuleID)
+}
+
+func TestAnalyzePathSecurityGroupDeny(t *testing.T) {
+	res := AnalyzePath(pathFixture(), PathPacket{SrcIP: webIP, DstIP: dbIP, Protocol: "tcp", DstPort: 3306})
+
+	th.AssertEquals(t, false, res.Reachable)
+	th.AssertEquals(t, pathComponentSecurityGroup, lastHop(res).Component)
+	th.AssertEquals(t, pathDecisionDeny, lastHop(res).Decision)
+}
+
+func TestAnalyzePathRemoteGroup(t *testing.T) {
+	// db security group allows only members of sg-web
+	res := AnalyzePath(pathFixture(), PathPacket{SrcIP: peerIP, DstIP: dbIP, Protocol: "tcp", DstPort: 5432})
+
+	th.AssertEquals(t, false, res.Reachable)
+}
+
+func TestAnalyzePathAddressGroup(t *testing.T) {
+	network := pathFixture()
+	network.SecurityGroups["sg-db"] = PathSecurityGroup{ID: "sg-db", Rules: []PathSecurityGroupRule{
+		{ID: "db-postgres", Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 5432, PortRangeMax: 5432, RemoteAddressGroupID: "ag-web"},
+	}}
+	network.AddressGroups = map[string][]string{"ag-web": {"10.0.0.1", "192.168.0.5-192.168.0.15"}}
+	packet := PathPacket{SrcIP: webIP, DstIP: dbIP, Protocol: "tcp", DstPort: 5432}
+
+	res := AnalyzePath(network, packet)
+	th.AssertEquals(t, true, res.Reachable)
+	th.AssertEquals(t, "db-postgres", lastHop(res).RuleID)
+
+	network.AddressGroups["ag-web"] = []string{"192.168.0.128/25"}
+	res = AnalyzePath(network, packet)
+	th.AssertEquals(t, false, res.Reachable)
+
+	// unresolved address group doesn't allow any traffic
+	delete(network.AddressGroups, "ag-web")
+	res = AnalyzePath(network, packet)
+	th.AssertEquals(t, false, res.Reachable)
+	th.AssertEquals(t, pathDecisionDeny, lastHop(res).Decision)
+}
+
+func TestAnalyzePathAnyProtocol(t *testing.T) {
+	// db security group allows only tcp
+	res := AnalyzePath(pathFixture(), PathPacket{SrcIP: webIP, DstIP: dbIP, Protocol: "any"})
+	th.AssertEquals(t, false, res.Reachable)
+	th.AssertEquals(t, pathComponentSecurityGroup, lastHop(res).Component)
+	th.AssertEquals(t, pathDecisionDeny, lastHop(res).Decision)
+
+	th.AssertEquals(t, true, protocolMatches("", "any"))
+	th.AssertEquals(t, true, protocolMatches("any", "any"))
+	th.AssertEquals(t, false, protocolMatches("tcp", "any"))
+	th.AssertEquals(t, true, protocolMatches("any", "tcp"))
+}
+
+func TestAnalyzePathEnterpriseRouter(t *testing.T) {
+	res := AnalyzePath(pathFixture(), PathPacket{SrcIP: webIP, DstIP: peerIP, Protocol: "tcp", DstPort: 22})
+
+	th.AssertEquals(t, true, res.Reachable)
+	th.AssertDeepEquals(t, []string{
+		"security_group:allow",
+		"route_table:forward",
+		"enterprise_router:forward",
+		"security_group:allow",
+	}, hopDecisions(res))
+	th.AssertEquals(t, "rtb-web", res.Hops[1].ResourceID)
+	th.AssertEquals(t, "er-route-peer", res.Hops[2].RuleID)
+}
+
+func TestAnalyzePathBlackhole(t *testing.T) {
+	network := pathFixture()
+	network.Endpoints["172.16.66.1"] = &PathEndpoint{IP: "172.16.66.1", VpcID: "vpc-2"}
+
+	res := AnalyzePath(network, PathPacket{SrcIP: webIP, DstIP: "172.16.66.1", Protocol: "tcp", DstPort: 22})
+
+	th.AssertEquals(t, false, res.Reachable)
+	th.AssertEquals(t, pathComponentER, lastHop(res).Component)
+	th.AssertEquals(t, "er-route-blackhole", lastHop(res).RuleID)
+}
+
+func TestAnalyzePathSNAT(t *testing.T) {
+	res := AnalyzePath(pathFixture(), PathPacket{SrcIP: webIP, DstIP: "8.8.8.8", Protocol: "udp", DstPort: 53})
+
+	th.AssertEquals(t, true, res.Reachable)
+	th.AssertDeepEquals(t, []string{
+		"security_group:allow",
+		"route_table:forward",
+		"snat:nat",
+		"destination:forward",
+	}, hopDecisions(res))
+	th.AssertEquals(t, "snat-web", res.Hops[2].RuleID)
+}
+
+func TestAnalyzePathNoSNATRule(t *testing.T) {
+	// db subnet uses the default route table, but has no SNAT rule
+	res := AnalyzePath(pathFixture(), PathPacket{SrcIP: dbIP, DstIP: "8.8.8.8", Protocol: "udp", DstPort: 53})
+
+	th.AssertEquals(t, false, res.Reachable)
+	th.AssertEquals(t, pathComponentSNAT, lastHop(res).Component)
+	th.AssertEquals(t, "nat-1", lastHop(res).ResourceID)
+}
+
+func TestAnalyzePathDNAT(t *testing.T) {
+	network := pathFixture()
+	network.Endpoints["10.0.0.5"] = &PathEndpoint{IP: "10.0.0.5", VpcID: "vpc-3", SubnetID: "subnet-3", SecurityGroupIDs: []string{"sg-client"}, EIP: "80.158.9.9"}
+	network.SecurityGroups["sg-client"] = PathSecurityGroup{ID: "sg-client", Rules: []PathSecurityGroupRule{
+		{ID: "client-egress", Direction: "egress"},
+	}}
+
+	res := AnalyzePath(network, PathPacket{SrcIP: "10.0.0.5", DstIP: "80.158.1.2", Protocol: "tcp", DstPort: 8443})
+
+	th.AssertEquals(t, true, res.Reachable)
+	th.AssertDeepEquals(t, []string{
+		"security_group:allow",
+		"eip:forward",
+		"dnat:nat",
+		"security_group:allow",
+	}, hopDecisions(res))
+	th.AssertEquals(t, "dnat-https", res.Hops[2].RuleID)
+	th.AssertEquals(t, "web-https", lastHop(res).RuleID)
+}
+
+func TestAnalyzePathFirewall(t *testing.T) {
+	network := pathFixture()
+	network.Firewalls = map[string]PathFirewallGroup{
+		"subnet-db": {
+			ID: "fw-1",
+			IngressRules: []PathFirewallRule{
+				{ID: "deny-web", Action: "deny", Protocol: "tcp", SourceIPAddress: webIP, Enabled: true},
+				{ID: "allow-all", Action: "allow", Enabled: true},
+			},
+		},
+	}
+
+	res := AnalyzePath(network, PathPacket{SrcIP: webIP, DstIP: dbIP, Protocol: "tcp", DstPort: 5432})
+	th.AssertEquals(t, false, res.Reachable)
+	th.AssertEquals(t, pathComponentFirewall, lastHop(res).Component)
+	th.AssertEquals(t, "deny-web", lastHop(res).RuleID)
+
+	// disabled rules are skipped
+	network.Firewalls["subnet-db"].IngressRules[0].Enabled = false
+	res = AnalyzePath(network, PathPacket{SrcIP: webIP, DstIP: dbIP, Protocol: "tcp", DstPort: 5432})
+	th.AssertEquals(t, true, res.Reachable)
+	th.AssertEquals(t, "allow-all", res.Hops[2].RuleID)
+}
+
+func TestAnalyzePathFirewallDefaultDeny(t *testing.T) {
+	network := pathFixture()
+	network.Firewalls = map[string]PathFirewallGroup{
+		"subnet-web": {
+			ID: "fw-1",
+			EgressRules: []PathFirewallRule{
+				{ID: "allow-dns", Action: "allow", Protocol: "udp", DestinationPort: "53", Enabled: true},
+			},
+		},
+	}
+
+	res := AnalyzePath(network, PathPacket{SrcIP: webIP, DstIP: dbIP, Protocol: "tcp", DstPort: 5432})
+	th.AssertEquals(t, false, res.Reachable)
+	th.AssertEquals(t, "fw-1", lastHop(res).ResourceID)
+	th.AssertEquals(t, "", lastHop(res).RuleID)
+}
+
+func TestAnalyzePathNoRoute(t *testing.T) {
+	network := pathFixture()
+	network.SNATRules = nil
+	network.RouteTables = []PathRouteTable{{ID: "rtb-default", VpcID: "vpc-1", Default: true}}
+
+	res := AnalyzePath(network, PathPacket{SrcIP: webIP, DstIP: "10.10.0.1", Protocol: "icmp"})
+	th.AssertEquals(t, false, res.Reachable)
+	th.AssertEquals(t, pathComponentRouteTable, lastHop(res).Component)
+
+	res = AnalyzePath(network, PathPacket{SrcIP: webIP, DstIP: "8.8.8.8", Protocol: "icmp"})
+	th.AssertEquals(t, false, res.Reachable)
+}
+
+func TestParseFirewallPorts(t *testing.T) {
+	min, max, err := parseFirewallPorts("80:90")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 80, min)
+	th.AssertEquals(t, 90, max)
+
+	_, _, err = parseFirewallPorts("http")
+	th.AssertEquals(t, true, err != nil)
+}
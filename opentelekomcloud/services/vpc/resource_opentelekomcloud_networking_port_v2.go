@@ -102,7 +102,8 @@ func ResourceNetworkingPortV2() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: false,
-				MaxItems: 1,
+				// one IPv4 and one IPv6 address of the dual-stack subnet
+				MaxItems: 2,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"subnet_id": {
@@ -164,6 +165,11 @@ func ResourceNetworkingPortV2() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"all_fixed_ipv6s": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"port_security_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -318,6 +324,7 @@ func resourceNetworkingPortV2Read(ctx context.Context, d *schema.ResourceData, m
 
 	mErr = multierror.Append(mErr,
 		d.Set("all_fixed_ips", ips),
+		d.Set("all_fixed_ipv6s", filterIPv6Addresses(ips)),
 		d.Set("allowed_address_pairs", pairs),
 	)
 
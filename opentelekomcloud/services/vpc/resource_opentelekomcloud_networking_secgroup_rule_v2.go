@@ -2,6 +2,7 @@ package vpc
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
 
@@ -27,7 +29,7 @@ func ResourceNetworkingSecGroupRuleV2() *schema.Resource {
 		ReadContext:   resourceNetworkingSecGroupRuleV2Read,
 		DeleteContext: resourceNetworkingSecGroupRuleV2Delete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceNetworkingSecGroupRuleV2Import,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -54,8 +56,12 @@ func ResourceNetworkingSecGroupRuleV2() *schema.Resource {
 			},
 			"ethertype": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"IPv4", "IPv6",
+				}, false),
 			},
 			"port_range_min": {
 				Type:         schema.TypeInt,
@@ -80,6 +86,12 @@ func ResourceNetworkingSecGroupRuleV2() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"remote_address_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"remote_group_id", "remote_ip_prefix"},
+			},
 			"remote_ip_prefix": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -126,10 +138,11 @@ func resourceNetworkingSecGroupRuleV2Create(ctx context.Context, d *schema.Resou
 		opts.Direction = direction
 	}
 
-	if v, ok := d.GetOk("ethertype"); ok {
-		etherType := resourceNetworkingSecGroupRuleV2DetermineEtherType(v.(string))
-		opts.EtherType = etherType
+	etherType, err := secGroupRuleEtherType(d.Get("ethertype").(string), opts.RemoteIPPrefix)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	opts.EtherType = resourceNetworkingSecGroupRuleV2DetermineEtherType(etherType)
 
 	if v, ok := d.GetOk("protocol"); ok {
 		protocol := resourceNetworkingSecGroupRuleV2DetermineProtocol(v.(string))
@@ -147,16 +160,42 @@ func resourceNetworkingSecGroupRuleV2Create(ctx context.Context, d *schema.Resou
 		}
 	}
 
-	log.Printf("[DEBUG] Create OpenTelekomCloud Neutron security group: %#v", opts)
+	if v, ok := d.GetOk("remote_address_group_id"); ok {
+		// address groups are supported only by the VPC v3 API
+		v3Client, err := config.NetworkingV3Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf(errCreationV3Client, err)
+		}
+		v3Opts := SecurityGroupRuleV3Opts{
+			SecurityGroupID:      opts.SecGroupID,
+			Description:          opts.Description,
+			Direction:            string(opts.Direction),
+			EtherType:            string(opts.EtherType),
+			Protocol:             string(opts.Protocol),
+			RemoteAddressGroupID: v.(string),
+		}
+		if _, ok := d.GetOk("port_range_min"); ok {
+			v3Opts.Multiport = formatSecGroupRulePorts(opts.PortRangeMin, opts.PortRangeMax)
+		}
+		log.Printf("[DEBUG] Create OpenTelekomCloud VPC v3 security group rule: %#v", v3Opts)
+
+		securityGroupRule, err := CreateSecurityGroupRuleV3(v3Client, v3Opts)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(securityGroupRule.ID)
+	} else {
+		log.Printf("[DEBUG] Create OpenTelekomCloud Neutron security group: %#v", opts)
 
-	securityGroupRule, err := rules.Create(client, opts).Extract()
-	if err != nil {
-		return diag.FromErr(err)
-	}
+		securityGroupRule, err := rules.Create(client, opts).Extract()
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	log.Printf("[DEBUG] OpenTelekomCloud Neutron Security Group Rule created: %#v", securityGroupRule)
+		log.Printf("[DEBUG] OpenTelekomCloud Neutron Security Group Rule created: %#v", securityGroupRule)
 
-	d.SetId(securityGroupRule.ID)
+		d.SetId(securityGroupRule.ID)
+	}
 
 	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
 	return resourceNetworkingSecGroupRuleV2Read(clientCtx, d, meta)
@@ -201,9 +240,36 @@ func resourceNetworkingSecGroupRuleV2Read(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	if d.Get("remote_address_group_id").(string) != "" {
+		readSecGroupRuleAddressGroup(config, d)
+	}
+
 	return nil
 }
 
+// readSecGroupRuleAddressGroup sets the address group, which is returned only by the v3 API.
+// The rule itself is read with the v2 API, so v3 errors only keep the current value.
+func readSecGroupRuleAddressGroup(config *cfg.Config, d *schema.ResourceData) {
+	v3Client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		log.Printf("[WARN] Unable to read address group of OpenTelekomCloud Security Group Rule %s: %s", d.Id(), err)
+		return
+	}
+	v3Rule, err := GetSecurityGroupRuleV3(v3Client, d.Id())
+	if err != nil {
+		log.Printf("[WARN] Unable to read address group of OpenTelekomCloud Security Group Rule %s: %s", d.Id(), err)
+		return
+	}
+	if err := d.Set("remote_address_group_id", v3Rule.RemoteAddressGroupID); err != nil {
+		log.Printf("[WARN] Unable to set address group of OpenTelekomCloud Security Group Rule %s: %s", d.Id(), err)
+	}
+}
+
+func resourceNetworkingSecGroupRuleV2Import(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	readSecGroupRuleAddressGroup(meta.(*cfg.Config), d)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceNetworkingSecGroupRuleV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
@@ -255,6 +321,24 @@ func resourceNetworkingSecGroupRuleV2DetermineEtherType(v string) rules.RuleEthe
 	return etherType
 }
 
+// secGroupRuleEtherType returns the ethertype matching the remote IP prefix if it's not configured, IPv4 otherwise
+func secGroupRuleEtherType(configured, remoteIPPrefix string) (string, error) {
+	if remoteIPPrefix == "" {
+		if configured == "" {
+			return "IPv4", nil
+		}
+		return configured, nil
+	}
+	etherType := "IPv4"
+	if strings.Contains(remoteIPPrefix, ":") {
+		etherType = "IPv6"
+	}
+	if configured != "" && configured != etherType {
+		return "", fmt.Errorf("ethertype %s doesn't match the remote IP prefix %s", configured, remoteIPPrefix)
+	}
+	return etherType, nil
+}
+
 func resourceNetworkingSecGroupRuleV2DetermineProtocol(v string) rules.RuleProtocol {
 	var protocol rules.RuleProtocol
 
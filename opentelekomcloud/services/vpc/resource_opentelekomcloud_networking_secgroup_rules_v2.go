@@ -0,0 +1,552 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceNetworkingSecGroupRulesV2 manages all rules of the security group at once,
+// rules not present in the configuration are removed from the group.
+func ResourceNetworkingSecGroupRulesV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingSecGroupRulesV2Update,
+		ReadContext:   resourceNetworkingSecGroupRulesV2Read,
+		UpdateContext: resourceNetworkingSecGroupRulesV2Update,
+		DeleteContext: resourceNetworkingSecGroupRulesV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("security_group_id"),
+		},
+
+		CustomizeDiff: validateSecGroupRules,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ingress", "egress",
+							}, false),
+						},
+						"ethertype": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"IPv4", "IPv6",
+							}, false),
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ports": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringMatch(
+									regexp.MustCompile(`^\d+(-\d+)?$`),
+									"port must be a number or a range like 8000-8080",
+								),
+							},
+						},
+						"remote_ip_prefixes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								StateFunc: func(v interface{}) string {
+									return strings.ToLower(v.(string))
+								},
+							},
+						},
+						"remote_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"remote_address_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// secGroupRuleKey identifies the API rule by all of its matching attributes,
+// rules which differ only in the description are duplicates for the API
+func secGroupRuleKey(direction, etherType, protocol string, portMin, portMax *int, remoteIP, remoteGroup, remoteAddressGroup string) string {
+	return strings.Join([]string{
+		direction, etherType, protocol, formatSecGroupRulePorts(portMin, portMax), strings.ToLower(remoteIP), remoteGroup, remoteAddressGroup,
+	}, "|")
+}
+
+// secGroupRuleStateKey additionally compares the description, which can't be updated in place
+func secGroupRuleStateKey(matchKey, description string) string {
+	return matchKey + "|" + description
+}
+
+func liveSecGroupRuleMatchKey(r rules.SecGroupRule, addressGroups map[string]string) string {
+	return secGroupRuleKey(r.Direction, r.EtherType, r.Protocol, r.PortRangeMin, r.PortRangeMax,
+		r.RemoteIPPrefix, r.RemoteGroupID, addressGroups[r.ID])
+}
+
+func liveSecGroupRuleKey(r rules.SecGroupRule, addressGroups map[string]string) string {
+	return secGroupRuleStateKey(liveSecGroupRuleMatchKey(r, addressGroups), r.Description)
+}
+
+// secGroupRule is the expanded API rule, address group rules are created with the VPC v3 API
+type secGroupRule struct {
+	rules.CreateOpts
+	RemoteAddressGroupID string
+	MatchKey             string
+}
+
+func formatSecGroupRulePorts(portMin, portMax *int) string {
+	switch {
+	case portMin == nil:
+		return ""
+	case portMax == nil || *portMin == *portMax:
+		return strconv.Itoa(*portMin)
+	default:
+		return fmt.Sprintf("%d-%d", *portMin, *portMax)
+	}
+}
+
+// parseSecGroupRulePorts parses the port range, for ICMP it's the type and code, so they are not compared
+func parseSecGroupRulePorts(protocol, v string) (*int, *int, error) {
+	parts := strings.SplitN(v, "-", 2)
+	portMin, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	portMax := portMin
+	if len(parts) == 2 {
+		if portMax, err = strconv.Atoi(parts[1]); err != nil {
+			return nil, nil, err
+		}
+	}
+	if portMin > portMax && protocol != "icmp" && protocol != "ipv6-icmp" {
+		return nil, nil, fmt.Errorf("start of the range is greater than its end")
+	}
+	return &portMin, &portMax, nil
+}
+
+const errSecGroupRulesEtherType = "ethertype has to be set in the rule without remote_ip_prefixes"
+
+// validateSecGroupRules checks the configured rule blocks, values unknown during the plan are skipped
+func validateSecGroupRules(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	blocks := d.GetRawConfig().GetAttr("rule")
+	if blocks.IsNull() || !blocks.IsKnown() {
+		return nil
+	}
+	for it := blocks.ElementIterator(); it.Next(); {
+		_, block := it.Element()
+		if block.IsNull() || !block.IsKnown() {
+			continue
+		}
+
+		protocol, ports := block.GetAttr("protocol"), block.GetAttr("ports")
+		if protocol.IsKnown() && !ports.IsNull() && ports.IsKnown() {
+			proto := ""
+			if !protocol.IsNull() {
+				proto = protocol.AsString()
+			}
+			for portIt := ports.ElementIterator(); portIt.Next(); {
+				_, port := portIt.Element()
+				if port.IsNull() || !port.IsKnown() {
+					continue
+				}
+				if _, _, err := parseSecGroupRulePorts(proto, port.AsString()); err != nil {
+					return fmt.Errorf("invalid port %s: %w", port.AsString(), err)
+				}
+			}
+		}
+
+		prefixes := block.GetAttr("remote_ip_prefixes")
+		if block.GetAttr("ethertype").IsNull() && (prefixes.IsNull() || prefixes.IsWhollyKnown() && prefixes.LengthInt() == 0) {
+			return fmt.Errorf(errSecGroupRulesEtherType)
+		}
+	}
+	return nil
+}
+
+// secGroupRulesEtherType requires the ethertype for the rules without prefixes, so the IPv6 rules
+// like the default IPv6 egress rule of the group are not silently replaced by the IPv4 ones
+func secGroupRulesEtherType(configured, remoteIPPrefix string) (string, error) {
+	if remoteIPPrefix == "" && configured == "" {
+		return "", fmt.Errorf(errSecGroupRulesEtherType)
+	}
+	return secGroupRuleEtherType(configured, remoteIPPrefix)
+}
+
+// expandSecGroupRules expands each `rule` block into the API rules,
+// one for every combination of the port ranges and remote prefixes.
+func expandSecGroupRules(secGroupID string, raw []interface{}) (map[string]secGroupRule, error) {
+	result := make(map[string]secGroupRule)
+	for _, r := range raw {
+		rule := r.(map[string]interface{})
+
+		remoteGroup := rule["remote_group_id"].(string)
+		remoteAddressGroup := rule["remote_address_group_id"].(string)
+		prefixes := common.ExpandToStringListBySet(rule["remote_ip_prefixes"].(*schema.Set))
+		remotes := 0
+		for _, set := range []bool{remoteGroup != "", remoteAddressGroup != "", len(prefixes) > 0} {
+			if set {
+				remotes++
+			}
+		}
+		if remotes > 1 {
+			return nil, fmt.Errorf("only one of remote_ip_prefixes, remote_group_id and remote_address_group_id can be set in the rule")
+		}
+		if len(prefixes) == 0 {
+			prefixes = []string{""}
+		}
+
+		ports := common.ExpandToStringListBySet(rule["ports"].(*schema.Set))
+		protocol := rule["protocol"].(string)
+		if len(ports) > 0 && protocol == "" {
+			return nil, fmt.Errorf("protocol has to be set in the rule with ports")
+		}
+		if len(ports) == 0 {
+			ports = []string{""}
+		}
+
+		for _, port := range ports {
+			var portMin, portMax *int
+			if port != "" {
+				var err error
+				if portMin, portMax, err = parseSecGroupRulePorts(protocol, port); err != nil {
+					return nil, fmt.Errorf("invalid port %s: %w", port, err)
+				}
+			}
+			for _, prefix := range prefixes {
+				etherType, err := secGroupRulesEtherType(rule["ethertype"].(string), prefix)
+				if err != nil {
+					return nil, err
+				}
+				opts := rules.CreateOpts{
+					Direction:      rules.RuleDirection(rule["direction"].(string)),
+					EtherType:      rules.RuleEtherType(etherType),
+					SecGroupID:     secGroupID,
+					Protocol:       resourceNetworkingSecGroupRuleV2DetermineProtocol(protocol),
+					PortRangeMin:   portMin,
+					PortRangeMax:   portMax,
+					RemoteIPPrefix: strings.ToLower(prefix),
+					RemoteGroupID:  remoteGroup,
+					Description:    rule["description"].(string),
+				}
+				matchKey := secGroupRuleKey(string(opts.Direction), string(opts.EtherType), string(opts.Protocol),
+					portMin, portMax, opts.RemoteIPPrefix, opts.RemoteGroupID, remoteAddressGroup)
+				result[secGroupRuleStateKey(matchKey, opts.Description)] = secGroupRule{
+					CreateOpts:           opts,
+					RemoteAddressGroupID: remoteAddressGroup,
+					MatchKey:             matchKey,
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// flattenSecGroupRules keeps the configured blocks which are completely present in the group
+// and adds each remaining API rule as a separate block, so missing and unknown rules show up in the plan.
+func flattenSecGroupRules(secGroupID string, configured []interface{}, live []rules.SecGroupRule, addressGroups map[string]string) []map[string]interface{} {
+	liveByKey := make(map[string]rules.SecGroupRule, len(live))
+	for _, r := range live {
+		liveByKey[liveSecGroupRuleKey(r, addressGroups)] = r
+	}
+
+	var result []map[string]interface{}
+	for _, raw := range configured {
+		expanded, err := expandSecGroupRules(secGroupID, []interface{}{raw})
+		if err != nil {
+			continue
+		}
+		present := true
+		for key := range expanded {
+			if _, ok := liveByKey[key]; !ok {
+				present = false
+				break
+			}
+		}
+		if !present {
+			continue
+		}
+		for key := range expanded {
+			delete(liveByKey, key)
+		}
+		rule := raw.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"direction":               rule["direction"],
+			"ethertype":               rule["ethertype"],
+			"protocol":                rule["protocol"],
+			"ports":                   rule["ports"].(*schema.Set).List(),
+			"remote_ip_prefixes":      rule["remote_ip_prefixes"].(*schema.Set).List(),
+			"remote_group_id":         rule["remote_group_id"],
+			"remote_address_group_id": rule["remote_address_group_id"],
+			"description":             rule["description"],
+		})
+	}
+
+	for _, r := range liveByKey {
+		var ports, prefixes []string
+		if p := formatSecGroupRulePorts(r.PortRangeMin, r.PortRangeMax); p != "" {
+			ports = append(ports, p)
+		}
+		if r.RemoteIPPrefix != "" {
+			prefixes = append(prefixes, strings.ToLower(r.RemoteIPPrefix))
+		}
+		result = append(result, map[string]interface{}{
+			"direction":               r.Direction,
+			"ethertype":               r.EtherType,
+			"protocol":                r.Protocol,
+			"ports":                   ports,
+			"remote_ip_prefixes":      prefixes,
+			"remote_group_id":         r.RemoteGroupID,
+			"remote_address_group_id": addressGroups[r.ID],
+			"description":             r.Description,
+		})
+	}
+	return result
+}
+
+func listSecGroupRules(client *golangsdk.ServiceClient, secGroupID string) ([]rules.SecGroupRule, error) {
+	pages, err := rules.List(client, rules.ListOpts{SecGroupID: secGroupID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return rules.ExtractRules(pages)
+}
+
+// listSecGroupRuleAddressGroups returns address groups of the rules by the rule ID,
+// the v2 API doesn't return them, so rules added out of band would look like rules without any remote.
+func listSecGroupRuleAddressGroups(config *cfg.Config, region, secGroupID string) (map[string]string, error) {
+	result := make(map[string]string)
+	client, err := config.NetworkingV3Client(region)
+	if err != nil {
+		return nil, fmt.Errorf(errCreationV3Client, err)
+	}
+	v3Rules, err := ListSecurityGroupRulesV3(client, secGroupID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range v3Rules {
+		if r.RemoteAddressGroupID != "" {
+			result[r.ID] = r.RemoteAddressGroupID
+		}
+	}
+	return result, nil
+}
+
+func createSecGroupRule(client *golangsdk.ServiceClient, config *cfg.Config, region string, rule secGroupRule) error {
+	if rule.RemoteAddressGroupID == "" {
+		_, err := rules.Create(client, rule.CreateOpts).Extract()
+		return err
+	}
+
+	v3Client, err := config.NetworkingV3Client(region)
+	if err != nil {
+		return fmt.Errorf(errCreationV3Client, err)
+	}
+	_, err = CreateSecurityGroupRuleV3(v3Client, SecurityGroupRuleV3Opts{
+		SecurityGroupID:      rule.SecGroupID,
+		Description:          rule.Description,
+		Direction:            string(rule.Direction),
+		EtherType:            string(rule.EtherType),
+		Protocol:             string(rule.Protocol),
+		Multiport:            formatSecGroupRulePorts(rule.PortRangeMin, rule.PortRangeMax),
+		RemoteAddressGroupID: rule.RemoteAddressGroupID,
+	})
+	return err
+}
+
+// planSecGroupRules removes the rules already present in the group from `desired` and returns IDs of the
+// obsolete rules: the ones to be replaced by a rule with another description and the ones to be deleted
+func planSecGroupRules(live []rules.SecGroupRule, addressGroups map[string]string, desired map[string]secGroupRule) ([]string, []string) {
+	var obsolete []rules.SecGroupRule
+	for _, r := range live {
+		key := liveSecGroupRuleKey(r, addressGroups)
+		if _, ok := desired[key]; ok {
+			delete(desired, key)
+			continue
+		}
+		obsolete = append(obsolete, r)
+	}
+
+	replacing := make(map[string]bool, len(desired))
+	for _, rule := range desired {
+		replacing[rule.MatchKey] = true
+	}
+	var toReplace, toDelete []string
+	for _, r := range obsolete {
+		if replacing[liveSecGroupRuleMatchKey(r, addressGroups)] {
+			toReplace = append(toReplace, r.ID)
+			continue
+		}
+		toDelete = append(toDelete, r.ID)
+	}
+	return toReplace, toDelete
+}
+
+func deleteSecGroupRule(client *golangsdk.ServiceClient, id string) error {
+	log.Printf("[DEBUG] Deleting OpenTelekomCloud Security Group Rule %s", id)
+	if err := rules.Delete(client, id).ExtractErr(); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmt.Errorf("error deleting OpenTelekomCloud Security Group Rule %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func resourceNetworkingSecGroupRulesV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	secGroupID := d.Get("security_group_id").(string)
+	desired, err := expandSecGroupRules(secGroupID, d.Get("rule").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	live, err := listSecGroupRules(client, secGroupID)
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud Security Group Rules: %w", err)
+	}
+	addressGroups, err := listSecGroupRuleAddressGroups(config, config.GetRegion(d), secGroupID)
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud Security Group Rules: %w", err)
+	}
+
+	toReplace, toDelete := planSecGroupRules(live, addressGroups, desired)
+
+	// new rules are created before the obsolete ones are removed, so the group is never left without rules,
+	// only the rules changing the description have to be removed first as the API rejects duplicates
+	for _, id := range toReplace {
+		if err := deleteSecGroupRule(client, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	for _, rule := range desired {
+		log.Printf("[DEBUG] Create OpenTelekomCloud Security Group Rule: %#v", rule)
+		if err := createSecGroupRule(client, config, config.GetRegion(d), rule); err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud Security Group Rule: %w", err)
+		}
+	}
+	for _, id := range toDelete {
+		if err := deleteSecGroupRule(client, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(secGroupID)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceNetworkingSecGroupRulesV2Read(clientCtx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	live, err := listSecGroupRules(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "OpenTelekomCloud Security Group Rules")
+	}
+	addressGroups, err := listSecGroupRuleAddressGroups(config, config.GetRegion(d), d.Id())
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud Security Group Rules: %w", err)
+	}
+
+	mErr := multierror.Append(
+		d.Set("security_group_id", d.Id()),
+		d.Set("rule", flattenSecGroupRules(d.Id(), d.Get("rule").(*schema.Set).List(), live, addressGroups)),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetworkingSecGroupRulesV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	managed, err := expandSecGroupRules(d.Id(), d.Get("rule").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	live, err := listSecGroupRules(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing OpenTelekomCloud Security Group Rules")
+	}
+	addressGroups, err := listSecGroupRuleAddressGroups(config, config.GetRegion(d), d.Id())
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud Security Group Rules: %w", err)
+	}
+	// only the rules from the state are removed, rules added after the last refresh are kept
+	for _, r := range live {
+		if _, ok := managed[liveSecGroupRuleKey(r, addressGroups)]; !ok {
+			continue
+		}
+		if err := deleteSecGroupRule(client, r.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
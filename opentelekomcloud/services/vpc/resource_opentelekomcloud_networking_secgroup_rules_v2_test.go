@@ -0,0 +1,238 @@
+package vpc
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const testSecGroupID = "sg-1"
+
+func testStringSet(values ...string) *schema.Set {
+	items := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		items = append(items, v)
+	}
+	return schema.NewSet(schema.HashString, items)
+}
+
+// testRuleBlock returns the `rule` block as it is passed by the SDK
+func testRuleBlock(direction, protocol string, ports, prefixes []string, remoteGroup, addressGroup, description string) map[string]interface{} {
+	return map[string]interface{}{
+		"direction":               direction,
+		"ethertype":               "",
+		"protocol":                protocol,
+		"ports":                   testStringSet(ports...),
+		"remote_ip_prefixes":      testStringSet(prefixes...),
+		"remote_group_id":         remoteGroup,
+		"remote_address_group_id": addressGroup,
+		"description":             description,
+	}
+}
+
+func withEtherType(block map[string]interface{}, etherType string) map[string]interface{} {
+	block["ethertype"] = etherType
+	return block
+}
+
+func testLiveRule(id, direction, etherType, protocol string, portMin, portMax int, prefix, description string) rules.SecGroupRule {
+	r := rules.SecGroupRule{
+		ID:             id,
+		Direction:      direction,
+		EtherType:      etherType,
+		Protocol:       protocol,
+		RemoteIPPrefix: prefix,
+		Description:    description,
+		SecGroupID:     testSecGroupID,
+	}
+	if portMin != 0 {
+		r.PortRangeMin, r.PortRangeMax = &portMin, &portMax
+	}
+	return r
+}
+
+func sortedKeys(m map[string]secGroupRule) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestExpandSecGroupRules(t *testing.T) {
+	expanded, err := expandSecGroupRules(testSecGroupID, []interface{}{
+		testRuleBlock("ingress", "tcp", []string{"22", "8000-8080"}, []string{"10.0.0.0/8", "2001:DB8::/32"}, "", "", "admin"),
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []string{
+		"ingress|IPv4|tcp|22|10.0.0.0/8|||admin",
+		"ingress|IPv4|tcp|8000-8080|10.0.0.0/8|||admin",
+		"ingress|IPv6|tcp|22|2001:db8::/32|||admin",
+		"ingress|IPv6|tcp|8000-8080|2001:db8::/32|||admin",
+	}, sortedKeys(expanded))
+
+	rule := expanded["ingress|IPv4|tcp|8000-8080|10.0.0.0/8|||admin"]
+	th.AssertEquals(t, 8000, *rule.PortRangeMin)
+	th.AssertEquals(t, 8080, *rule.PortRangeMax)
+	th.AssertEquals(t, testSecGroupID, rule.SecGroupID)
+	th.AssertEquals(t, "admin", rule.Description)
+	th.AssertEquals(t, "ingress|IPv4|tcp|8000-8080|10.0.0.0/8||", rule.MatchKey)
+}
+
+func TestExpandSecGroupRulesRemotes(t *testing.T) {
+	expanded, err := expandSecGroupRules(testSecGroupID, []interface{}{
+		withEtherType(testRuleBlock("egress", "", nil, nil, "", "", ""), "IPv4"),
+		withEtherType(testRuleBlock("egress", "", nil, nil, "", "", ""), "IPv6"),
+		withEtherType(testRuleBlock("ingress", "udp", []string{"53"}, nil, "sg-2", "", ""), "IPv4"),
+		withEtherType(testRuleBlock("ingress", "tcp", []string{"443"}, nil, "", "ag-1", ""), "IPv4"),
+		withEtherType(testRuleBlock("ingress", "icmp", []string{"8-0"}, nil, "", "", ""), "IPv4"),
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []string{
+		"egress|IPv4||||||",
+		"egress|IPv6||||||",
+		"ingress|IPv4|icmp|8-0||||",
+		"ingress|IPv4|tcp|443|||ag-1|",
+		"ingress|IPv4|udp|53||sg-2||",
+	}, sortedKeys(expanded))
+	th.AssertEquals(t, "ag-1", expanded["ingress|IPv4|tcp|443|||ag-1|"].RemoteAddressGroupID)
+}
+
+func TestExpandSecGroupRulesErrors(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"several remotes":      testRuleBlock("ingress", "tcp", nil, []string{"10.0.0.0/8"}, "sg-2", "", ""),
+		"ports without proto":  testRuleBlock("ingress", "", []string{"22"}, nil, "", "", ""),
+		"invalid port":         testRuleBlock("ingress", "tcp", []string{"22-x"}, nil, "", "", ""),
+		"ethertype mismatched": testRuleBlock("ingress", "tcp", nil, []string{"2001:db8::/32"}, "", "", ""),
+		"reversed port range":  testRuleBlock("ingress", "tcp", []string{"80-22"}, []string{"10.0.0.0/8"}, "", "", ""),
+		"ethertype missing":    testRuleBlock("egress", "", nil, nil, "", "", ""),
+	}
+	cases["ethertype mismatched"]["ethertype"] = "IPv4"
+
+	for name, block := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := expandSecGroupRules(testSecGroupID, []interface{}{block})
+			if err == nil {
+				t.Fatalf("expected error for %s", name)
+			}
+		})
+	}
+}
+
+func TestFlattenSecGroupRules(t *testing.T) {
+	configured := []interface{}{
+		// completely present, kept as configured
+		testRuleBlock("ingress", "tcp", []string{"22", "443"}, []string{"10.0.0.0/8"}, "", "", ""),
+		// only one of two rules present, dropped so the missing rule is planned
+		testRuleBlock("ingress", "udp", []string{"53", "123"}, []string{"10.0.0.0/8"}, "", "", ""),
+		// present with another description, dropped so the description change is planned
+		withEtherType(testRuleBlock("egress", "", nil, nil, "", "", "new"), "IPv4"),
+	}
+	live := []rules.SecGroupRule{
+		testLiveRule("r1", "ingress", "IPv4", "tcp", 22, 22, "10.0.0.0/8", ""),
+		testLiveRule("r2", "ingress", "IPv4", "tcp", 443, 443, "10.0.0.0/8", ""),
+		testLiveRule("r3", "ingress", "IPv4", "udp", 53, 53, "10.0.0.0/8", ""),
+		testLiveRule("r4", "egress", "IPv4", "", 0, 0, "", "old"),
+		// added out of band with an address group
+		testLiveRule("r5", "ingress", "IPv4", "tcp", 8080, 8080, "", ""),
+	}
+	addressGroups := map[string]string{"r5": "ag-1"}
+
+	flattened := flattenSecGroupRules(testSecGroupID, configured, live, addressGroups)
+	th.AssertEquals(t, 4, len(flattened))
+
+	th.AssertDeepEquals(t, []interface{}{"22", "443"}, sortedList(flattened[0]["ports"].([]interface{})))
+
+	byPort := make(map[string]map[string]interface{})
+	for _, rule := range flattened[1:] {
+		ports := rule["ports"].([]string)
+		if len(ports) == 0 {
+			byPort[""] = rule
+			continue
+		}
+		byPort[ports[0]] = rule
+	}
+	th.AssertEquals(t, "udp", byPort["53"]["protocol"])
+	th.AssertDeepEquals(t, []string{"10.0.0.0/8"}, byPort["53"]["remote_ip_prefixes"])
+	th.AssertEquals(t, "old", byPort[""]["description"])
+	th.AssertEquals(t, "ag-1", byPort["8080"]["remote_address_group_id"])
+}
+
+func sortedList(items []interface{}) []interface{} {
+	sort.Slice(items, func(i, j int) bool { return items[i].(string) < items[j].(string) })
+	return items
+}
+
+func TestPlanSecGroupRules(t *testing.T) {
+	cases := []struct {
+		name          string
+		blocks        []interface{}
+		live          []rules.SecGroupRule
+		addressGroups map[string]string
+		create        []string
+		replace       []string
+		delete        []string
+	}{
+		{
+			name:   "in sync",
+			blocks: []interface{}{testRuleBlock("ingress", "tcp", []string{"22"}, []string{"10.0.0.0/8"}, "", "", "ssh")},
+			live:   []rules.SecGroupRule{testLiveRule("r1", "ingress", "IPv4", "tcp", 22, 22, "10.0.0.0/8", "ssh")},
+		},
+		{
+			name: "default egress rules kept",
+			blocks: []interface{}{
+				withEtherType(testRuleBlock("egress", "", nil, nil, "", "", ""), "IPv4"),
+				withEtherType(testRuleBlock("egress", "", nil, nil, "", "", ""), "IPv6"),
+			},
+			live: []rules.SecGroupRule{
+				testLiveRule("r1", "egress", "IPv4", "", 0, 0, "", ""),
+				testLiveRule("r2", "egress", "IPv6", "", 0, 0, "", ""),
+			},
+		},
+		{
+			name:   "new and obsolete",
+			blocks: []interface{}{testRuleBlock("ingress", "tcp", []string{"443"}, []string{"10.0.0.0/8"}, "", "", "")},
+			live:   []rules.SecGroupRule{testLiveRule("r1", "ingress", "IPv4", "tcp", 22, 22, "10.0.0.0/8", "")},
+			create: []string{"ingress|IPv4|tcp|443|10.0.0.0/8|||"},
+			delete: []string{"r1"},
+		},
+		{
+			name:    "description changed",
+			blocks:  []interface{}{testRuleBlock("ingress", "tcp", []string{"22"}, []string{"10.0.0.0/8"}, "", "", "new")},
+			live:    []rules.SecGroupRule{testLiveRule("r1", "ingress", "IPv4", "tcp", 22, 22, "10.0.0.0/8", "old")},
+			create:  []string{"ingress|IPv4|tcp|22|10.0.0.0/8|||new"},
+			replace: []string{"r1"},
+		},
+		{
+			name:          "address group rule is not a rule without remote",
+			blocks:        []interface{}{withEtherType(testRuleBlock("ingress", "tcp", []string{"22"}, nil, "", "", ""), "IPv4")},
+			live:          []rules.SecGroupRule{testLiveRule("r1", "ingress", "IPv4", "tcp", 22, 22, "", "")},
+			addressGroups: map[string]string{"r1": "ag-1"},
+			create:        []string{"ingress|IPv4|tcp|22||||"},
+			delete:        []string{"r1"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			desired, err := expandSecGroupRules(testSecGroupID, c.blocks)
+			th.AssertNoErr(t, err)
+
+			toReplace, toDelete := planSecGroupRules(c.live, c.addressGroups, desired)
+
+			create := sortedKeys(desired)
+			if len(create) == 0 {
+				create = nil
+			}
+			th.AssertDeepEquals(t, c.create, create)
+			th.AssertDeepEquals(t, c.replace, toReplace)
+			th.AssertDeepEquals(t, c.delete, toDelete)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package vpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceVpcAddressGroupV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVpcAddressGroupV3Create,
+		ReadContext:   resourceVpcAddressGroupV3Read,
+		UpdateContext: resourceVpcAddressGroupV3Update,
+		DeleteContext: resourceVpcAddressGroupV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"ip_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      4,
+				ValidateFunc: validation.IntInSlice([]int{4, 6}),
+			},
+			"addresses": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_capacity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVpcAddressGroupV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	description := d.Get("description").(string)
+	opts := AddressGroupOpts{
+		Name:        d.Get("name").(string),
+		Description: &description,
+		IpVersion:   d.Get("ip_version").(int),
+		IpSet:       common.ExpandToStringListBySet(d.Get("addresses").(*schema.Set)),
+		MaxCapacity: d.Get("max_capacity").(int),
+	}
+	log.Printf("[DEBUG] Create OpenTelekomCloud VPC address group: %#v", opts)
+
+	group, err := CreateAddressGroup(client, opts)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud VPC address group: %w", err)
+	}
+	d.SetId(group.ID)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceVpcAddressGroupV3Read(clientCtx, d, meta)
+}
+
+func resourceVpcAddressGroupV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	group, err := GetAddressGroup(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "OpenTelekomCloud VPC address group")
+	}
+
+	mErr := multierror.Append(
+		d.Set("name", group.Name),
+		d.Set("description", group.Description),
+		d.Set("ip_version", group.IpVersion),
+		d.Set("addresses", group.IpSet),
+		d.Set("max_capacity", group.MaxCapacity),
+		d.Set("status", group.Status),
+		d.Set("created_at", group.CreatedAt),
+		d.Set("updated_at", group.UpdatedAt),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceVpcAddressGroupV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	description := d.Get("description").(string)
+	opts := AddressGroupOpts{
+		Name:        d.Get("name").(string),
+		Description: &description,
+		IpSet:       common.ExpandToStringListBySet(d.Get("addresses").(*schema.Set)),
+		MaxCapacity: d.Get("max_capacity").(int),
+	}
+	log.Printf("[DEBUG] Update OpenTelekomCloud VPC address group: %#v", opts)
+
+	if _, err := UpdateAddressGroup(client, d.Id(), opts); err != nil {
+		return fmterr.Errorf("error updating OpenTelekomCloud VPC address group: %w", err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV3)
+	return resourceVpcAddressGroupV3Read(clientCtx, d, meta)
+}
+
+func resourceVpcAddressGroupV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	if err := DeleteAddressGroup(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting OpenTelekomCloud VPC address group")
+	}
+
+	return nil
+}
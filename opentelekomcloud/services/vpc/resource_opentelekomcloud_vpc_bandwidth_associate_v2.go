@@ -10,6 +10,7 @@ import (
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/bandwidths"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
@@ -33,8 +34,14 @@ func ResourceBandwidthAssociateV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"floating_ips": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"floating_ips", "ipv6_port_ids"},
+			},
+			"ipv6_port_ids": {
 				Type:     schema.TypeSet,
-				Required: true,
+				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"backup_charge_mode": {
@@ -62,7 +69,13 @@ func resourceBandwidthAssociateV2Create(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(d.Get("bandwidth").(string))
 
-	ips := d.Get("floating_ips").(*schema.Set)
+	ips, err := filterExistingBandwidthIPs(client,
+		d.Get("floating_ips").(*schema.Set),
+		d.Get("ipv6_port_ids").(*schema.Set),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if err := addIPsToBandwidth(client, d, ips); err != nil {
 		return diag.FromErr(err)
 	}
@@ -84,13 +97,19 @@ func resourceBandwidthAssociateV2Read(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "error getting bandwidth info")
 	}
-	ips := make([]string, len(bandwidth.PublicIpInfo))
-	for i, ipInfo := range bandwidth.PublicIpInfo {
-		ips[i] = ipInfo.ID
+	var ips, ipv6Ports []string
+	for _, ipInfo := range bandwidth.PublicIpInfo {
+		// IPv6 addresses are bound to the bandwidth by the port ID
+		if ipInfo.IPVersion == 6 {
+			ipv6Ports = append(ipv6Ports, ipInfo.ID)
+			continue
+		}
+		ips = append(ips, ipInfo.ID)
 	}
 	mErr := multierror.Append(
 		d.Set("bandwidth", d.Id()),
 		d.Set("floating_ips", ips),
+		d.Set("ipv6_port_ids", ipv6Ports),
 	)
 	if err := mErr.ErrorOrNil(); err != nil {
 		return fmterr.Errorf("error setting bandwidth associate fields: %w", err)
@@ -108,10 +127,21 @@ func resourceBandwidthAssociateV2Update(ctx context.Context, d *schema.ResourceD
 		return fmterr.Errorf(errCreationV2Client, err)
 	}
 
-	removed, added := common.GetSetChanges(d, "floating_ips")
+	removedIPs, addedIPs := common.GetSetChanges(d, "floating_ips")
+	removedPorts, addedPorts := common.GetSetChanges(d, "ipv6_port_ids")
+
+	removed, err := filterExistingBandwidthIPs(client, removedIPs, removedPorts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if err := removeIPsFromBandwidth(client, d, removed); err != nil {
 		return diag.FromErr(err)
 	}
+
+	added, err := filterExistingBandwidthIPs(client, addedIPs, addedPorts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if err := addIPsToBandwidth(client, d, added); err != nil {
 		return diag.FromErr(err)
 	}
@@ -129,7 +159,13 @@ func resourceBandwidthAssociateV2Delete(ctx context.Context, d *schema.ResourceD
 		return fmterr.Errorf(errCreationV2Client, err)
 	}
 
-	ips := d.Get("floating_ips").(*schema.Set)
+	ips, err := filterExistingBandwidthIPs(client,
+		d.Get("floating_ips").(*schema.Set),
+		d.Get("ipv6_port_ids").(*schema.Set),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if err := removeIPsFromBandwidth(client, d, ips); err != nil {
 		return diag.FromErr(err)
 	}
@@ -138,10 +174,6 @@ func resourceBandwidthAssociateV2Delete(ctx context.Context, d *schema.ResourceD
 }
 
 func addIPsToBandwidth(client *golangsdk.ServiceClient, d *schema.ResourceData, ips *schema.Set) error {
-	ips, err := filterExistingFloatingIPs(client, ips)
-	if err != nil {
-		return err
-	}
 	if ips.Len() == 0 {
 		return nil
 	}
@@ -161,10 +193,6 @@ func addIPsToBandwidth(client *golangsdk.ServiceClient, d *schema.ResourceData,
 }
 
 func removeIPsFromBandwidth(client *golangsdk.ServiceClient, d *schema.ResourceData, ips *schema.Set) error {
-	ips, err := filterExistingFloatingIPs(client, ips)
-	if err != nil {
-		return err
-	}
 	if ips.Len() == 0 {
 		return nil
 	}
@@ -188,6 +216,25 @@ func removeIPsFromBandwidth(client *golangsdk.ServiceClient, d *schema.ResourceD
 	return nil
 }
 
+// filterExistingBandwidthIPs returns existing floating IPs and IPv6 ports as a single set of bandwidth public IP IDs
+func filterExistingBandwidthIPs(clientV2 *golangsdk.ServiceClient, ipIDs, portIDs *schema.Set) (*schema.Set, error) {
+	filtered, err := filterExistingFloatingIPs(clientV2, ipIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range portIDs.List() {
+		_, err := ports.Get(clientV2, id.(string)).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return nil, fmt.Errorf("error fetching port %s: %w", id, err)
+		}
+		filtered.Add(id)
+	}
+	return filtered, nil
+}
+
 // filterExistingFloatingIPs returns only existing IPs from given slice
 func filterExistingFloatingIPs(clientV2 *golangsdk.ServiceClient, ipIDs *schema.Set) (*schema.Set, error) {
 	filtered := schema.NewSet(schema.HashString, []interface{}{})
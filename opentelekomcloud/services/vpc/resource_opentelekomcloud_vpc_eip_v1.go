@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/bandwidths"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -34,6 +37,9 @@ func ResourceVpcEIPV1() *schema.Resource {
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+
+		CustomizeDiff: validateBandwidth,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -70,6 +76,13 @@ func ResourceVpcEIPV1() *schema.Resource {
 							Computed: true,
 							ForceNew: true,
 						},
+						"ip_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntInSlice([]int{4, 6}),
+						},
 					},
 				},
 			},
@@ -80,13 +93,21 @@ func ResourceVpcEIPV1() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
 						"name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
+							Computed: true,
 						},
 						"size": {
 							Type:     schema.TypeInt,
-							Required: true,
+							Optional: true,
+							Computed: true,
 						},
 						"share_type": {
 							Type:     schema.TypeString,
@@ -178,12 +199,13 @@ func resourceVpcEIPV1Read(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	// Set public ip
-	publicIP := []map[string]string{
+	publicIP := []map[string]interface{}{
 		{
 			"type":       eip.Type,
 			"ip_address": eip.PublicAddress,
 			"port_id":    eip.PortID,
 			"name":       eip.Name,
+			"ip_version": eip.IpVersion,
 		},
 	}
 	if err := d.Set("publicip", publicIP); err != nil {
@@ -193,6 +215,7 @@ func resourceVpcEIPV1Read(ctx context.Context, d *schema.ResourceData, meta inte
 	// Set bandwidth
 	bw := []map[string]interface{}{
 		{
+			"id":          eip.BandwidthID,
 			"name":        bandWidth.Name,
 			"size":        eip.BandwidthSize,
 			"share_type":  eip.BandwidthShareType,
@@ -325,13 +348,37 @@ func resourcePublicIP(d *schema.ResourceData) eips.PublicIpOpts {
 		Type:    publicIPRaw["type"].(string),
 		Address: publicIPRaw["ip_address"].(string),
 	}
+	if v := publicIPRaw["ip_version"].(int); v != 0 {
+		publicIpOpts.Version = strconv.Itoa(v)
+	}
 	return publicIpOpts
 }
 
+// validateBandwidth checks that a dedicated bandwidth is either an existing one or has `name` and `size` set
+func validateBandwidth(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	bandwidths := d.GetRawConfig().GetAttr("bandwidth")
+	if bandwidths.IsNull() || !bandwidths.IsKnown() || bandwidths.LengthInt() == 0 {
+		return nil
+	}
+	bandwidth := bandwidths.Index(cty.NumberIntVal(0))
+	shareType := bandwidth.GetAttr("share_type")
+	if shareType.IsNull() || !shareType.IsKnown() || shareType.AsString() != "PER" {
+		return nil
+	}
+	if !bandwidth.GetAttr("id").IsNull() {
+		return nil
+	}
+	if bandwidth.GetAttr("name").IsNull() || bandwidth.GetAttr("size").IsNull() {
+		return fmt.Errorf("either bandwidth `id` or both `name` and `size` must be set with `share_type = \"PER\"`")
+	}
+	return nil
+}
+
 func resourceBandWidth(d *schema.ResourceData) eips.BandwidthOpts {
 	bandwidthRaw := d.Get("bandwidth").([]interface{})[0].(map[string]interface{})
 
 	bandwidthOpts := eips.BandwidthOpts{
+		Id:         bandwidthRaw["id"].(string),
 		Name:       bandwidthRaw["name"].(string),
 		Size:       bandwidthRaw["size"].(int),
 		ShareType:  bandwidthRaw["share_type"].(string),
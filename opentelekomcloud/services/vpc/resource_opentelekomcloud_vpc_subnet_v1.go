@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
+	networkingsubnets "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/subnets"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
@@ -125,6 +126,10 @@ func ResourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ipv6_subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"network_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -239,6 +244,14 @@ func resourceVpcSubnetV1Read(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("region", config.GetRegion(d)),
 	)
 
+	if subnet.EnableIpv6 {
+		ipv6SubnetID, err := getIPv6SubnetID(config, config.GetRegion(d), subnet.NetworkID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		mErr = multierror.Append(mErr, d.Set("ipv6_subnet_id", ipv6SubnetID))
+	}
+
 	for _, opt := range subnet.ExtraDHCPOpts {
 		if opt.OptName == "ntp" {
 			mErr = multierror.Append(mErr, d.Set("ntp_addresses", opt.OptValue))
@@ -396,3 +409,26 @@ func waitForVpcSubnetDelete(client *golangsdk.ServiceClient, vpcID string, subne
 		return subnet, "ACTIVE", nil
 	}
 }
+
+// getIPv6SubnetID returns ID of the IPv6 subnet created in the network of dual-stack VPC subnet
+func getIPv6SubnetID(config *cfg.Config, region, networkID string) (string, error) {
+	client, err := config.NetworkingV2Client(region)
+	if err != nil {
+		return "", fmt.Errorf(errCreationV2Client, err)
+	}
+	pages, err := networkingsubnets.List(client, networkingsubnets.ListOpts{
+		NetworkID: networkID,
+		IPVersion: 6,
+	}).AllPages()
+	if err != nil {
+		return "", fmt.Errorf("error listing IPv6 subnets: %w", err)
+	}
+	ipv6Subnets, err := networkingsubnets.ExtractSubnets(pages)
+	if err != nil {
+		return "", fmt.Errorf("error extracting IPv6 subnets: %w", err)
+	}
+	if len(ipv6Subnets) == 0 {
+		return "", nil
+	}
+	return ipv6Subnets[0].ID, nil
+}
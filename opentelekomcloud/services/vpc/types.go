@@ -1,6 +1,8 @@
 package vpc
 
 import (
+	"strconv"
+
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/routers"
@@ -85,3 +87,22 @@ type EIPCreateOpts struct {
 	eips.ApplyOpts
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
+
+// ToPublicIpApplyMap casts a ApplyOpts struct to a map.
+// It overrides eips.ToPublicIpApplyMap as the API expects `ip_version` as a number.
+func (opts EIPCreateOpts) ToPublicIpApplyMap() (map[string]interface{}, error) {
+	b, err := opts.ApplyOpts.ToPublicIpApplyMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if m, ok := b["publicip"].(map[string]interface{}); ok {
+		if v, ok := m["ip_version"].(string); ok {
+			if m["ip_version"], err = strconv.Atoi(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return b, nil
+}
@@ -0,0 +1,246 @@
+package waf
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// timeRangeSchema returns time filter arguments shared by WAF dedicated event and statistics data sources
+func timeRangeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"recent": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"recent", "begin_time"},
+			ValidateFunc: validation.StringInSlice(recentPeriods, false),
+			Description:  "Relative query period. Possible values: yesterday, today, 3days, 1week, 1month. This parameter is mutually exclusive with begin_time and end_time.",
+		},
+		"begin_time": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"end_time"},
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "Start time of the query in RFC3339 format. This parameter is mutually exclusive with recent.",
+		},
+		"end_time": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"begin_time"},
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "End time of the query in RFC3339 format. This parameter is mutually exclusive with recent.",
+		},
+	}
+}
+
+func timeRange(d *schema.ResourceData) (int64, int64, error) {
+	if recent, ok := d.GetOk("recent"); ok {
+		return recentTimeRange(recent.(string), time.Now())
+	}
+	return parseTimeRange(d.Get("begin_time").(string), d.Get("end_time").(string))
+}
+
+func DataSourceWafDedicatedEventsV1() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"hosts": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "IDs of the protected domains.",
+		},
+		"attacks": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Attack types. Possible values: xss, sqli, cmdi, lfi, rfi, webshell, robot, cc, custom_custom, custom_whiteblackip, custom_geoip, antitamper, anticrawler, leakage, illegal, vuln.",
+		},
+		"events": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of attack events returned from the query.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Event ID.",
+					},
+					"time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Time of the attack.",
+					},
+					"policy_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of the policy.",
+					},
+					"source_ip": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Source IP address.",
+					},
+					"host": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Domain name.",
+					},
+					"host_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of the domain.",
+					},
+					"url": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Attacked URL.",
+					},
+					"attack": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Attack type.",
+					},
+					"rule": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of the matched rule.",
+					},
+					"payload": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Malicious payload.",
+					},
+					"action": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Protective action. Possible values: block, log, captcha.",
+					},
+					"request_line": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Request method and path.",
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Response code.",
+					},
+					"region": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Geographical location of the source IP address.",
+					},
+					"response_time": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Response time in milliseconds.",
+					},
+					"response_size": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Response body size in bytes.",
+					},
+					"process_time": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "WAF processing time in milliseconds.",
+					},
+				},
+			},
+		},
+		"region": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for k, v := range timeRangeSchema() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceWafDedicatedEventsV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceWafDedicatedEventsV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	opts := ListEventsOpts{
+		Recent:  d.Get("recent").(string),
+		Hosts:   common.ExpandToStringSlice(d.Get("hosts").(*schema.Set).List()),
+		Attacks: common.ExpandToStringSlice(d.Get("attacks").(*schema.Set).List()),
+	}
+	if opts.Recent == "" {
+		opts.From, opts.To, err = timeRange(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	events, err := ListEvents(client, opts)
+	if err != nil {
+		return diag.Errorf("unable to list OpenTelekomCloud WAF Dedicated events: %s", err)
+	}
+
+	if len(events) == 0 {
+		log.Printf("[DEBUG] No WAF Dedicated events in OpenTelekomCloud found")
+	}
+
+	uuId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+
+	d.SetId(uuId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("events", flattenWafEvents(events)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenWafEvents(events []Event) []interface{} {
+	if len(events) == 0 {
+		return nil
+	}
+
+	rst := make([]interface{}, 0, len(events))
+	for _, e := range events {
+		rst = append(rst, map[string]interface{}{
+			"id":            e.ID,
+			"time":          formatMilliseconds(e.Time),
+			"policy_id":     e.PolicyID,
+			"source_ip":     e.SourceIP,
+			"host":          e.Host,
+			"host_id":       e.HostID,
+			"url":           e.Url,
+			"attack":        e.Attack,
+			"rule":          e.Rule,
+			"payload":       e.Payload,
+			"action":        e.Action,
+			"request_line":  e.RequestLine,
+			"status":        e.Status,
+			"region":        e.Region,
+			"response_time": e.ResponseTime,
+			"response_size": e.ResponseSize,
+			"process_time":  e.ProcessTime,
+		})
+	}
+	return rst
+}
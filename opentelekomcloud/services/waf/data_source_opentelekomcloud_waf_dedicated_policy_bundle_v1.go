@@ -0,0 +1,62 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceWafDedicatedPolicyBundleV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWafDedicatedPolicyBundleV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"document": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceWafDedicatedPolicyBundleV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	policyID := d.Get("policy_id").(string)
+	bundle, _, err := readPolicyBundle(client, policyID)
+	if err != nil {
+		return fmterr.Errorf("error reading OpenTelekomCloud WAF Dedicated policy bundle: %s", err)
+	}
+	document, err := renderPolicyBundle(bundle)
+	if err != nil {
+		return fmterr.Errorf("error rendering OpenTelekomCloud WAF Dedicated policy bundle: %s", err)
+	}
+	d.SetId(policyID)
+
+	mErr := multierror.Append(nil,
+		d.Set("document", document),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting WAF Dedicated policy bundle fields: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceWafDedicatedQpsV1() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"host_ids": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "IDs of the protected domains. Statistics are returned for each domain separately. If omitted, statistics of all domains are aggregated.",
+		},
+		"instances": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "IDs of the dedicated WAF instances.",
+		},
+		"group_by": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"DAY"}, false),
+			Description:  "Aggregation interval. If omitted, the interval is chosen automatically based on the time range.",
+		},
+		"statistics": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "QPS statistics returned from the query.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"host_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of the domain. Empty for the aggregated statistics.",
+					},
+					"key": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Statistics type, e.g. totalQps or attackQps.",
+					},
+					"timeline": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "QPS values over the time range.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"time": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Start of the interval.",
+								},
+								"count": {
+									Type:        schema.TypeInt,
+									Computed:    true,
+									Description: "QPS value.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"region": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for k, v := range timeRangeSchema() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceWafDedicatedQpsV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceWafDedicatedQpsV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	from, to, err := timeRange(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opts := QpsTimelineOpts{
+		From:      from,
+		To:        to,
+		Instances: common.ExpandToStringSlice(d.Get("instances").(*schema.Set).List()),
+		GroupBy:   d.Get("group_by").(string),
+	}
+
+	hostIDs := common.ExpandToStringSlice(d.Get("host_ids").(*schema.Set).List())
+	if len(hostIDs) == 0 {
+		hostIDs = []string{""}
+	}
+
+	var statistics []interface{}
+	for _, hostID := range hostIDs {
+		opts.Hosts = nil
+		if hostID != "" {
+			opts.Hosts = []string{hostID}
+		}
+		timelines, err := ListQpsTimeline(client, opts)
+		if err != nil {
+			return diag.Errorf("unable to get OpenTelekomCloud WAF Dedicated QPS statistics: %s", err)
+		}
+		for _, timeline := range timelines {
+			statistics = append(statistics, map[string]interface{}{
+				"host_id":  hostID,
+				"key":      timeline.Key,
+				"timeline": flattenTimeline(timeline.Timeline),
+			})
+		}
+	}
+
+	uuId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+
+	d.SetId(uuId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("statistics", statistics),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenTimeline(points []TimelinePoint) []interface{} {
+	rst := make([]interface{}, 0, len(points))
+	for _, point := range points {
+		rst = append(rst, map[string]interface{}{
+			"time":  formatMilliseconds(point.Time),
+			"count": point.Num,
+		})
+	}
+	return rst
+}
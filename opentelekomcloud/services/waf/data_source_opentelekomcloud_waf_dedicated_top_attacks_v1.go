@@ -0,0 +1,130 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func statisticsItemsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Statistics item, e.g. URL or IP address.",
+				},
+				"count": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of attacks.",
+				},
+			},
+		},
+	}
+}
+
+func DataSourceWafDedicatedTopAttacksV1() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"hosts": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "IDs of the protected domains.",
+		},
+		"instances": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "IDs of the dedicated WAF instances.",
+		},
+		"top": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      5,
+			ValidateFunc: validation.IntBetween(1, 10),
+			Description:  "Number of top items returned in each category.",
+		},
+		"urls":         statisticsItemsSchema("Most attacked URLs."),
+		"ips":          statisticsItemsSchema("Source IP addresses of the most attacks."),
+		"domains":      statisticsItemsSchema("Most attacked domains."),
+		"attack_types": statisticsItemsSchema("Most frequent attack types."),
+		"locations":    statisticsItemsSchema("Geographical locations of the most attacks."),
+		"region": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for k, v := range timeRangeSchema() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceWafDedicatedTopAttacksV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceWafDedicatedTopAttacksV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	from, to, err := timeRange(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	stats, err := GetClassification(client, StatisticsOpts{
+		From:      from,
+		To:        to,
+		Hosts:     common.ExpandToStringSlice(d.Get("hosts").(*schema.Set).List()),
+		Instances: common.ExpandToStringSlice(d.Get("instances").(*schema.Set).List()),
+		Top:       d.Get("top").(int),
+	})
+	if err != nil {
+		return diag.Errorf("unable to get OpenTelekomCloud WAF Dedicated attack statistics: %s", err)
+	}
+
+	uuId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+
+	d.SetId(uuId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("urls", flattenStatisticsItems(stats.Url.Items)),
+		d.Set("ips", flattenStatisticsItems(stats.IP.Items)),
+		d.Set("domains", flattenStatisticsItems(stats.Domain.Items)),
+		d.Set("attack_types", flattenStatisticsItems(stats.AttackType.Items)),
+		d.Set("locations", flattenStatisticsItems(stats.Geo.Items)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenStatisticsItems(items []StatisticsItem) []interface{} {
+	rst := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		rst = append(rst, map[string]interface{}{
+			"key":   item.Key,
+			"count": item.Num,
+		})
+	}
+	return rst
+}
@@ -0,0 +1,211 @@
+package waf
+
+import (
+	"fmt"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Dedicated WAF events and dashboard statistics are not covered by gophertelekomcloud yet.
+
+const (
+	eventsPageSize = 100
+
+	recentYesterday = "yesterday"
+	recentToday     = "today"
+	recent3Days     = "3days"
+	recentWeek      = "1week"
+	recentMonth     = "1month"
+)
+
+var recentPeriods = []string{recentYesterday, recentToday, recent3Days, recentWeek, recentMonth}
+
+type ListEventsOpts struct {
+	// Relative query period, mutually exclusive with From and To
+	Recent  string   `q:"recent,omitempty"`
+	From    int64    `q:"from,omitempty"`
+	To      int64    `q:"to,omitempty"`
+	Hosts   []string `q:"hosts,omitempty"`
+	Attacks []string `q:"attacks,omitempty"`
+	Page    int      `q:"page,omitempty"`
+	// Number of records on each page, maximum is 100
+	PageSize int `q:"pagesize,omitempty"`
+}
+
+type Event struct {
+	ID           string `json:"id"`
+	Time         int64  `json:"time"`
+	PolicyID     string `json:"policyid"`
+	SourceIP     string `json:"sip"`
+	Host         string `json:"host"`
+	HostID       string `json:"host_id"`
+	Url          string `json:"url"`
+	Attack       string `json:"attack"`
+	Rule         string `json:"rule"`
+	Payload      string `json:"payload"`
+	Action       string `json:"action"`
+	RequestLine  string `json:"request_line"`
+	Status       string `json:"status"`
+	Region       string `json:"region"`
+	ResponseTime int64  `json:"response_time"`
+	ResponseSize int64  `json:"response_size"`
+	ProcessTime  int64  `json:"process_time"`
+}
+
+type StatisticsOpts struct {
+	From      int64    `q:"from" required:"true"`
+	To        int64    `q:"to" required:"true"`
+	Hosts     []string `q:"hosts,omitempty"`
+	Instances []string `q:"instances,omitempty"`
+	// Number of top items returned in each category
+	Top int `q:"top,omitempty"`
+}
+
+type StatisticsItem struct {
+	Key string `json:"key"`
+	Num int64  `json:"num"`
+}
+
+type StatisticsCategory struct {
+	Total int64            `json:"total"`
+	Items []StatisticsItem `json:"items"`
+}
+
+type Classification struct {
+	Domain     StatisticsCategory `json:"domain"`
+	AttackType StatisticsCategory `json:"attack_type"`
+	IP         StatisticsCategory `json:"ip"`
+	Url        StatisticsCategory `json:"url"`
+	Geo        StatisticsCategory `json:"geo"`
+}
+
+type QpsTimelineOpts struct {
+	From      int64    `q:"from" required:"true"`
+	To        int64    `q:"to" required:"true"`
+	Hosts     []string `q:"hosts,omitempty"`
+	Instances []string `q:"instances,omitempty"`
+	// Aggregation interval, e.g. DAY
+	GroupBy string `q:"group_by,omitempty"`
+}
+
+type TimelinePoint struct {
+	Time int64 `json:"time"`
+	Num  int64 `json:"num"`
+}
+
+type QpsTimeline struct {
+	Key      string          `json:"key"`
+	Timeline []TimelinePoint `json:"timeline"`
+}
+
+// ListEvents returns all attack events, going through all the pages
+func ListEvents(client *golangsdk.ServiceClient, opts ListEventsOpts) ([]Event, error) {
+	if opts.PageSize == 0 {
+		opts.PageSize = eventsPageSize
+	}
+	opts.Page = 1
+
+	var events []Event
+	for {
+		q, err := golangsdk.BuildQueryString(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// GET /v1/{project_id}/waf/event
+		var res struct {
+			Total int     `json:"total"`
+			Items []Event `json:"items"`
+		}
+		_, err = client.Get(client.ServiceURL("waf", "event")+q.String(), &res, &golangsdk.RequestOpts{
+			OkCodes:     []int{200},
+			MoreHeaders: map[string]string{"Content-Type": "application/json;charset=utf8"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, res.Items...)
+		if len(res.Items) == 0 || len(events) >= res.Total {
+			return events, nil
+		}
+		opts.Page++
+	}
+}
+
+func GetClassification(client *golangsdk.ServiceClient, opts StatisticsOpts) (*Classification, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// GET /v1/{project_id}/waf/overviews/classification
+	var res Classification
+	_, err = client.Get(client.ServiceURL("waf", "overviews", "classification")+q.String(), &res, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: map[string]string{"Content-Type": "application/json;charset=utf8"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func ListQpsTimeline(client *golangsdk.ServiceClient, opts QpsTimelineOpts) ([]QpsTimeline, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// GET /v1/{project_id}/waf/overviews/qps/timeline
+	var res []QpsTimeline
+	_, err = client.Get(client.ServiceURL("waf", "overviews", "qps", "timeline")+q.String(), &res, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: map[string]string{"Content-Type": "application/json;charset=utf8"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// recentTimeRange converts relative query period to the time range in milliseconds
+func recentTimeRange(recent string, now time.Time) (int64, int64, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch recent {
+	case recentYesterday:
+		return today.AddDate(0, 0, -1).UnixMilli(), today.UnixMilli() - 1, nil
+	case recentToday:
+		return today.UnixMilli(), now.UnixMilli(), nil
+	case recent3Days:
+		return today.AddDate(0, 0, -2).UnixMilli(), now.UnixMilli(), nil
+	case recentWeek:
+		return today.AddDate(0, 0, -6).UnixMilli(), now.UnixMilli(), nil
+	case recentMonth:
+		return today.AddDate(0, 0, -29).UnixMilli(), now.UnixMilli(), nil
+	}
+	return 0, 0, fmt.Errorf("unsupported query period: %s", recent)
+}
+
+// parseTimeRange returns the time range in milliseconds from RFC3339 begin and end times
+func parseTimeRange(beginTime, endTime string) (int64, int64, error) {
+	begin, err := time.Parse(time.RFC3339, beginTime)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error parsing begin_time: %s", err)
+	}
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error parsing end_time: %s", err)
+	}
+	if !end.After(begin) {
+		return 0, 0, fmt.Errorf("end_time has to be after begin_time")
+	}
+	return begin.UnixMilli(), end.UnixMilli(), nil
+}
+
+func formatMilliseconds(ms int64) string {
+	if ms == 0 {
+		return ""
+	}
+	return time.UnixMilli(ms).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,735 @@
+package waf
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+// policyBundle is a portable representation of a dedicated WAF policy with all its rules.
+// Environment specific values like IDs, names of the policy and timestamps are not included.
+type policyBundle struct {
+	Policy map[string]interface{}              `json:"policy"`
+	Rules  map[string][]map[string]interface{} `json:"rules"`
+}
+
+type bundleRule struct {
+	ID   string
+	Body map[string]interface{}
+}
+
+type bundleRuleType struct {
+	// path is the rule type used in API URLs
+	path string
+	// keys identify the same rule in the current and desired state, so it can be updated in place
+	keys []string
+	// opts is create options of the rule, its JSON fields are the fields of the bundle rule
+	opts interface{}
+	// fixup fills fields which are accepted on creation, but not returned by the API
+	fixup  func(rule map[string]interface{})
+	list   func(client *golangsdk.ServiceClient, policyID string) (interface{}, error)
+	create func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error)
+	// update is nil for rules which can't be changed, they are recreated instead
+	update func(client *golangsdk.ServiceClient, policyID, ruleID string, body map[string]interface{}) error
+	delete func(client *golangsdk.ServiceClient, policyID, ruleID string) error
+}
+
+const (
+	bundleRuleStatusField = "status"
+	bundleRuleEnabled     = 1
+)
+
+var bundlePolicyFields = []string{"level", "full_detection", "action", "robot_action", "options"}
+
+var bundleRuleTypes = map[string]bundleRuleType{
+	"blacklist": {
+		path: "whiteblackip",
+		keys: []string{"name"},
+		opts: rules.BlacklistCreateOpts{},
+		list: func(client *golangsdk.ServiceClient, policyID string) (interface{}, error) {
+			return rules.ListBlacklists(client, policyID, rules.ListBlacklistOpts{})
+		},
+		create: func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error) {
+			var opts rules.BlacklistCreateOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return "", err
+			}
+			rule, err := rules.CreateBlacklist(client, policyID, opts)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		update: func(client *golangsdk.ServiceClient, policyID, ruleID string, body map[string]interface{}) error {
+			var opts rules.UpdateBlacklistOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return err
+			}
+			_, err := rules.UpdateBlacklist(client, policyID, ruleID, opts)
+			return err
+		},
+		delete: rules.DeleteBlacklistRule,
+	},
+	"cc": {
+		path: "cc",
+		keys: []string{"url"},
+		opts: rules.CreateCcOpts{},
+		list: func(client *golangsdk.ServiceClient, policyID string) (interface{}, error) {
+			return rules.ListCcs(client, policyID, rules.ListCcOpts{})
+		},
+		create: func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error) {
+			var opts rules.CreateCcOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return "", err
+			}
+			rule, err := rules.CreateCc(client, policyID, opts)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		update: func(client *golangsdk.ServiceClient, policyID, ruleID string, body map[string]interface{}) error {
+			var opts rules.CreateCcOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return err
+			}
+			_, err := rules.UpdateCc(client, policyID, ruleID, opts)
+			return err
+		},
+		delete: rules.DeleteCcRule,
+	},
+	"precise_protection": {
+		path: "custom",
+		keys: []string{"description"},
+		opts: rules.CreateCustomOpts{},
+		fixup: func(rule map[string]interface{}) {
+			if _, ok := rule["time"]; !ok {
+				rule["time"] = !isEmptyBundleValue(rule["start"]) || !isEmptyBundleValue(rule["terminal"])
+			}
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string) (interface{}, error) {
+			return rules.ListCustoms(client, policyID, rules.ListCustomOpts{})
+		},
+		create: func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error) {
+			var opts rules.CreateCustomOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return "", err
+			}
+			rule, err := rules.CreateCustom(client, policyID, opts)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		update: func(client *golangsdk.ServiceClient, policyID, ruleID string, body map[string]interface{}) error {
+			var opts rules.CreateCustomOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return err
+			}
+			_, err := rules.UpdateCustom(client, policyID, ruleID, opts)
+			return err
+		},
+		delete: rules.DeleteCustomRule,
+	},
+	"anti_crawler": {
+		path: "anticrawler",
+		keys: []string{"type", "name"},
+		opts: rules.CreateAntiCrawlerOpts{},
+		list: func(client *golangsdk.ServiceClient, policyID string) (interface{}, error) {
+			// rules of different protection modes are listed separately
+			var all []rules.AntiCrawlerRule
+			for _, mode := range []string{"anticrawler_specific_url", "anticrawler_except_url"} {
+				found, err := rules.ListAntiCrawlers(client, policyID, rules.ListAntiCrawlerOpts{Type: mode})
+				if err != nil {
+					return nil, err
+				}
+				all = append(all, found...)
+			}
+			return all, nil
+		},
+		create: func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error) {
+			var opts rules.CreateAntiCrawlerOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return "", err
+			}
+			rule, err := rules.CreateAntiCrawler(client, policyID, opts)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		update: func(client *golangsdk.ServiceClient, policyID, ruleID string, body map[string]interface{}) error {
+			var opts rules.UpdateAntiCrawlerOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return err
+			}
+			_, err := rules.UpdateAntiCrawler(client, policyID, ruleID, opts)
+			return err
+		},
+		delete: rules.DeleteAntiCrawlerRule,
+	},
+	"anti_leakage": {
+		path: "antileakage",
+		keys: []string{"url", "category"},
+		opts: rules.CreateAntiLeakageOpts{},
+		list: func(client *golangsdk.ServiceClient, policyID string) (interface{}, error) {
+			return rules.ListAntiLeakage(client, policyID, rules.ListAntiLeakageOpts{})
+		},
+		create: func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error) {
+			var opts rules.CreateAntiLeakageOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return "", err
+			}
+			rule, err := rules.CreateAntiLeakage(client, policyID, opts)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		update: func(client *golangsdk.ServiceClient, policyID, ruleID string, body map[string]interface{}) error {
+			var opts rules.UpdateAntiLeakageOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return err
+			}
+			_, err := rules.UpdateAntiLeakage(client, policyID, ruleID, opts)
+			return err
+		},
+		delete: rules.DeleteAntiLeakageRule,
+	},
+	"web_tamper": {
+		path: "antitamper",
+		keys: []string{"hostname", "url"},
+		opts: rules.CreateAntiTamperOpts{},
+		list: func(client *golangsdk.ServiceClient, policyID string) (interface{}, error) {
+			return rules.ListAntiTamper(client, policyID, rules.ListAntiTamperOpts{})
+		},
+		create: func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error) {
+			var opts rules.CreateAntiTamperOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return "", err
+			}
+			rule, err := rules.CreateAntiTamper(client, policyID, opts)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		delete: rules.DeleteAntiTamperRule,
+	},
+	"data_masking": {
+		path: "privacy",
+		keys: []string{"index"},
+		opts: rules.CreatePrivacyOpts{},
+		list: func(client *golangsdk.ServiceClient, policyID string) (interface{}, error) {
+			return rules.ListPrivacy(client, policyID, rules.ListPrivacyOpts{})
+		},
+		create: func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error) {
+			var opts rules.CreatePrivacyOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return "", err
+			}
+			rule, err := rules.CreatePrivacy(client, policyID, opts)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		update: func(client *golangsdk.ServiceClient, policyID, ruleID string, body map[string]interface{}) error {
+			var opts rules.UpdatePrivacyOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return err
+			}
+			_, err := rules.UpdatePrivacy(client, policyID, ruleID, opts)
+			return err
+		},
+		delete: rules.DeletePrivacyRule,
+	},
+	"geo_ip": {
+		path: "geoip",
+		keys: []string{"name"},
+		opts: rules.CreateGeoIpOpts{},
+		list: func(client *golangsdk.ServiceClient, policyID string) (interface{}, error) {
+			return rules.ListGeoIp(client, policyID, rules.ListGeoIpOpts{})
+		},
+		create: func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error) {
+			var opts rules.CreateGeoIpOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return "", err
+			}
+			rule, err := rules.CreateGeoIp(client, policyID, opts)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		update: func(client *golangsdk.ServiceClient, policyID, ruleID string, body map[string]interface{}) error {
+			var opts rules.UpdateGeoIpOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return err
+			}
+			_, err := rules.UpdateGeoIp(client, policyID, ruleID, opts)
+			return err
+		},
+		delete: rules.DeleteGeoIpRule,
+	},
+	"known_attack_source": {
+		path: "punishment",
+		keys: []string{"category"},
+		opts: rules.CreateKnownAttackSourceOpts{},
+		list: func(client *golangsdk.ServiceClient, policyID string) (interface{}, error) {
+			return rules.ListKnownAttackSource(client, policyID, rules.ListKnownAttackSourceOpts{})
+		},
+		create: func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error) {
+			var opts rules.CreateKnownAttackSourceOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return "", err
+			}
+			rule, err := rules.CreateKnownAttackSource(client, policyID, opts)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		update: func(client *golangsdk.ServiceClient, policyID, ruleID string, body map[string]interface{}) error {
+			var opts rules.UpdateKnownAttackSourceOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return err
+			}
+			_, err := rules.UpdateKnownAttackSource(client, policyID, ruleID, opts)
+			return err
+		},
+		delete: rules.DeleteKnownAttackSourceRule,
+	},
+	"alarm_masking": {
+		path: "ignore",
+		keys: []string{"rule"},
+		opts: rules.CreateIgnoreOpts{},
+		list: func(client *golangsdk.ServiceClient, policyID string) (interface{}, error) {
+			return rules.ListIgnore(client, policyID, rules.ListIgnoreOpts{})
+		},
+		create: func(client *golangsdk.ServiceClient, policyID string, body map[string]interface{}) (string, error) {
+			var opts rules.CreateIgnoreOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return "", err
+			}
+			rule, err := rules.CreateIgnore(client, policyID, opts)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		update: func(client *golangsdk.ServiceClient, policyID, ruleID string, body map[string]interface{}) error {
+			var opts rules.CreateIgnoreOpts
+			if err := decodeBundleRule(body, &opts); err != nil {
+				return err
+			}
+			_, err := rules.UpdateIgnore(client, policyID, ruleID, opts)
+			return err
+		},
+		delete: rules.DeleteIgnoreRule,
+	},
+}
+
+func sortedBundleRuleTypes() []string {
+	names := make([]string, 0, len(bundleRuleTypes))
+	for name := range bundleRuleTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func decodeBundleRule(body map[string]interface{}, opts interface{}) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, opts)
+}
+
+// bundleFields returns JSON field names of the options struct
+func bundleFields(opts interface{}) map[string]bool {
+	fields := map[string]bool{}
+	t := reflect.TypeOf(opts)
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			fields[name] = true
+		}
+	}
+	return fields
+}
+
+func isEmptyBundleValue(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case float64:
+		return value == 0
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	}
+	return false
+}
+
+// normalizeBundleValue drops empty strings, lists and objects, so omitted and empty values are equal
+func normalizeBundleValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			item = normalizeBundleValue(item)
+			if item == nil {
+				continue
+			}
+			if s, ok := item.(string); ok && s == "" {
+				continue
+			}
+			result[key] = item
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			if item = normalizeBundleValue(item); item != nil {
+				result = append(result, item)
+			}
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	}
+	return v
+}
+
+// canonicalBundleRule keeps only portable fields of the rule
+func canonicalBundleRule(ruleType bundleRuleType, raw map[string]interface{}, strict bool) (map[string]interface{}, error) {
+	fields := bundleFields(ruleType.opts)
+	fields[bundleRuleStatusField] = true
+
+	rule := make(map[string]interface{}, len(raw))
+	for key, value := range raw {
+		if !fields[key] {
+			if strict {
+				return nil, fmt.Errorf("unsupported field %q", key)
+			}
+			continue
+		}
+		rule[key] = value
+	}
+	if ruleType.fixup != nil {
+		ruleType.fixup(rule)
+	}
+
+	normalized, _ := normalizeBundleValue(rule).(map[string]interface{})
+	if normalized == nil {
+		normalized = map[string]interface{}{}
+	}
+	if _, ok := normalized[bundleRuleStatusField]; !ok {
+		normalized[bundleRuleStatusField] = float64(bundleRuleEnabled)
+	}
+	return normalized, nil
+}
+
+func bundleRuleKey(rule map[string]interface{}) string {
+	b, _ := json.Marshal(rule)
+	return string(b)
+}
+
+func sortBundleRules(list []map[string]interface{}) {
+	sort.Slice(list, func(i, j int) bool {
+		return bundleRuleKey(list[i]) < bundleRuleKey(list[j])
+	})
+}
+
+func canonicalBundlePolicy(raw map[string]interface{}) map[string]interface{} {
+	policy := make(map[string]interface{}, len(bundlePolicyFields))
+	for _, field := range bundlePolicyFields {
+		if value, ok := raw[field]; ok {
+			policy[field] = value
+		}
+	}
+	normalized, _ := normalizeBundleValue(policy).(map[string]interface{})
+	return normalized
+}
+
+// parsePolicyBundle parses the document and brings it to the canonical form
+func parsePolicyBundle(document string) (*policyBundle, error) {
+	var raw struct {
+		Policy map[string]interface{}              `json:"policy"`
+		Rules  map[string][]map[string]interface{} `json:"rules"`
+	}
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return nil, fmt.Errorf("error parsing WAF policy bundle: %s", err)
+	}
+
+	bundle := &policyBundle{
+		Rules: map[string][]map[string]interface{}{},
+	}
+	if raw.Policy != nil {
+		for field := range raw.Policy {
+			if !common.StrSliceContains(bundlePolicyFields, field) {
+				return nil, fmt.Errorf("unsupported policy field %q, valid fields are: %s", field, strings.Join(bundlePolicyFields, ", "))
+			}
+		}
+		bundle.Policy = canonicalBundlePolicy(raw.Policy)
+	}
+	for typeName, list := range raw.Rules {
+		ruleType, ok := bundleRuleTypes[typeName]
+		if !ok {
+			return nil, fmt.Errorf("unsupported rule type %q, valid types are: %s", typeName, strings.Join(sortedBundleRuleTypes(), ", "))
+		}
+		for i, item := range list {
+			rule, err := canonicalBundleRule(ruleType, item, true)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s rule #%d: %s", typeName, i, err)
+			}
+			bundle.Rules[typeName] = append(bundle.Rules[typeName], rule)
+		}
+		sortBundleRules(bundle.Rules[typeName])
+	}
+	return bundle, nil
+}
+
+func renderPolicyBundle(bundle *policyBundle) (string, error) {
+	rendered := policyBundle{
+		Policy: bundle.Policy,
+		Rules:  map[string][]map[string]interface{}{},
+	}
+	if rendered.Policy == nil {
+		rendered.Policy = map[string]interface{}{}
+	}
+	for typeName, list := range bundle.Rules {
+		if len(list) > 0 {
+			rendered.Rules[typeName] = list
+		}
+	}
+	b, err := json.MarshalIndent(rendered, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func toBundleMap(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var res map[string]interface{}
+	err = json.Unmarshal(b, &res)
+	return res, err
+}
+
+func listBundleRules(client *golangsdk.ServiceClient, policyID string, ruleType bundleRuleType) ([]bundleRule, error) {
+	found, err := ruleType.list(client, policyID)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(found)
+	if err != nil {
+		return nil, err
+	}
+	var raw []map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	result := make([]bundleRule, 0, len(raw))
+	for _, item := range raw {
+		id, _ := item["id"].(string)
+		body, err := canonicalBundleRule(ruleType, item, false)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, bundleRule{ID: id, Body: body})
+	}
+	return result, nil
+}
+
+// readPolicyBundle reads the policy and all its rules
+func readPolicyBundle(client *golangsdk.ServiceClient, policyID string) (*policyBundle, map[string][]bundleRule, error) {
+	policy, err := policies.Get(client, policyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rawPolicy, err := toBundleMap(policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundle := &policyBundle{
+		Policy: canonicalBundlePolicy(rawPolicy),
+		Rules:  map[string][]map[string]interface{}{},
+	}
+	current := map[string][]bundleRule{}
+	for _, typeName := range sortedBundleRuleTypes() {
+		found, err := listBundleRules(client, policyID, bundleRuleTypes[typeName])
+		if err != nil {
+			return nil, nil, fmt.Errorf("error listing %s rules: %s", typeName, err)
+		}
+		current[typeName] = found
+		for _, rule := range found {
+			bundle.Rules[typeName] = append(bundle.Rules[typeName], rule.Body)
+		}
+		sortBundleRules(bundle.Rules[typeName])
+	}
+	return bundle, current, nil
+}
+
+type bundleRulePlan struct {
+	create []map[string]interface{}
+	update []bundleRule
+	delete []bundleRule
+}
+
+func bundleKeysEqual(keys []string, a, b map[string]interface{}) bool {
+	for _, key := range keys {
+		if !reflect.DeepEqual(a[key], b[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// planBundleRules matches desired rules with the existing ones, at first by the whole content,
+// then by identity keys of the rule type. Matched by keys rules are updated, others recreated.
+func planBundleRules(ruleType bundleRuleType, current []bundleRule, desired []map[string]interface{}) bundleRulePlan {
+	used := make([]bool, len(current))
+	var pending []map[string]interface{}
+	for _, rule := range desired {
+		matched := false
+		for i, existing := range current {
+			if !used[i] && bundleRuleKey(existing.Body) == bundleRuleKey(rule) {
+				used[i], matched = true, true
+				break
+			}
+		}
+		if !matched {
+			pending = append(pending, rule)
+		}
+	}
+
+	plan := bundleRulePlan{}
+	for _, rule := range pending {
+		matched := false
+		if ruleType.update != nil {
+			for i, existing := range current {
+				if !used[i] && bundleKeysEqual(ruleType.keys, existing.Body, rule) {
+					used[i], matched = true, true
+					plan.update = append(plan.update, bundleRule{ID: existing.ID, Body: rule})
+					break
+				}
+			}
+		}
+		if !matched {
+			plan.create = append(plan.create, rule)
+		}
+	}
+	for i, existing := range current {
+		if !used[i] {
+			plan.delete = append(plan.delete, existing)
+		}
+	}
+	return plan
+}
+
+func bundleRuleStatus(rule map[string]interface{}) int {
+	status, _ := rule[bundleRuleStatusField].(float64)
+	return int(status)
+}
+
+func setBundleRuleStatus(client *golangsdk.ServiceClient, policyID string, ruleType bundleRuleType, ruleID string, status int) error {
+	_, err := rules.ChangeRuleStatus(client, policyID, ruleType.path, ruleID, rules.ChangeStatusOpts{Status: status})
+	return err
+}
+
+// applyPolicyBundle brings the policy to the state described by the bundle
+func applyPolicyBundle(client *golangsdk.ServiceClient, policyID string, desired *policyBundle) error {
+	_, current, err := readPolicyBundle(client, policyID)
+	if err != nil {
+		return err
+	}
+
+	if len(desired.Policy) > 0 {
+		policy, err := policies.Get(client, policyID)
+		if err != nil {
+			return err
+		}
+		var opts policies.UpdateOpts
+		if err := decodeBundleRule(desired.Policy, &opts); err != nil {
+			return fmt.Errorf("error decoding policy settings: %s", err)
+		}
+		opts.Name = policy.Name
+		if _, err := policies.Update(client, policyID, opts); err != nil {
+			return fmt.Errorf("error updating policy settings: %s", err)
+		}
+	}
+
+	for _, typeName := range sortedBundleRuleTypes() {
+		ruleType := bundleRuleTypes[typeName]
+		plan := planBundleRules(ruleType, current[typeName], desired.Rules[typeName])
+
+		for _, rule := range plan.delete {
+			if err := ruleType.delete(client, policyID, rule.ID); err != nil {
+				return fmt.Errorf("error deleting %s rule %s: %s", typeName, rule.ID, err)
+			}
+		}
+		for _, rule := range plan.update {
+			if err := ruleType.update(client, policyID, rule.ID, rule.Body); err != nil {
+				return fmt.Errorf("error updating %s rule %s: %s", typeName, rule.ID, err)
+			}
+			for _, existing := range current[typeName] {
+				if existing.ID == rule.ID && bundleRuleStatus(existing.Body) != bundleRuleStatus(rule.Body) {
+					if err := setBundleRuleStatus(client, policyID, ruleType, rule.ID, bundleRuleStatus(rule.Body)); err != nil {
+						return fmt.Errorf("error changing status of %s rule %s: %s", typeName, rule.ID, err)
+					}
+				}
+			}
+		}
+		for _, rule := range plan.create {
+			id, err := ruleType.create(client, policyID, rule)
+			if err != nil {
+				return fmt.Errorf("error creating %s rule: %s", typeName, err)
+			}
+			if bundleRuleStatus(rule) != bundleRuleEnabled {
+				if err := setBundleRuleStatus(client, policyID, ruleType, id, bundleRuleStatus(rule)); err != nil {
+					return fmt.Errorf("error changing status of %s rule %s: %s", typeName, id, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// deletePolicyBundleRules deletes all rules of the policy, policy settings are kept
+func deletePolicyBundleRules(client *golangsdk.ServiceClient, policyID string) error {
+	_, current, err := readPolicyBundle(client, policyID)
+	if err != nil {
+		return err
+	}
+	for _, typeName := range sortedBundleRuleTypes() {
+		for _, rule := range current[typeName] {
+			if err := bundleRuleTypes[typeName].delete(client, policyID, rule.ID); err != nil {
+				return fmt.Errorf("error deleting %s rule %s: %s", typeName, rule.ID, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package waf
+
+import (
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestParsePolicyBundle(t *testing.T) {
+	bundle, err := parsePolicyBundle(`
+{
+  "policy": {"level": 2, "options": {"webattack": true, "cc": false}, "action": {}},
+  "rules": {
+    "blacklist": [
+      {"name": "office", "addr": "10.0.0.0/8", "white": 1, "status": 0},
+      {"name": "attackers", "addr": "192.168.0.1", "white": 0, "description": ""}
+    ]
+  }
+}`)
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, map[string]interface{}{
+		"level":   float64(2),
+		"options": map[string]interface{}{"webattack": true, "cc": false},
+	}, bundle.Policy)
+	// rules are sorted by their content
+	th.AssertDeepEquals(t, []map[string]interface{}{
+		// explicit status is kept
+		{"name": "office", "addr": "10.0.0.0/8", "white": float64(1), "status": float64(0)},
+		// status defaults to enabled, empty values are dropped
+		{"name": "attackers", "addr": "192.168.0.1", "white": float64(0), "status": float64(bundleRuleEnabled)},
+	}, bundle.Rules["blacklist"])
+}
+
+func TestParsePolicyBundleErrors(t *testing.T) {
+	cases := map[string]string{
+		"invalid json":      `{"policy": `,
+		"policy field":      `{"policy": {"name": "policy"}}`,
+		"rule type":         `{"rules": {"unknown": [{}]}}`,
+		"rule field":        `{"rules": {"blacklist": [{"name": "office", "id": "rule-id"}]}}`,
+		"non-portable rule": `{"rules": {"cc": [{"url": "/login", "timestamp": 1700000000000}]}}`,
+	}
+	for name, document := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parsePolicyBundle(document); err == nil {
+				t.Fatalf("expected error for %s", name)
+			}
+		})
+	}
+}
+
+func TestCanonicalBundleRule(t *testing.T) {
+	cases := []struct {
+		name     string
+		ruleType string
+		raw      map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "API fields dropped",
+			ruleType: "blacklist",
+			raw: map[string]interface{}{
+				"id": "rule-id", "policyid": "policy-id", "timestamp": float64(1700000000000),
+				"name": "office", "addr": "10.0.0.0/8", "white": float64(1), "status": float64(1),
+			},
+			expected: map[string]interface{}{
+				"name": "office", "addr": "10.0.0.0/8", "white": float64(1), "status": float64(1),
+			},
+		},
+		{
+			name:     "status defaulted",
+			ruleType: "web_tamper",
+			raw:      map[string]interface{}{"hostname": "www.example.com", "url": "/admin", "description": ""},
+			expected: map[string]interface{}{"hostname": "www.example.com", "url": "/admin", "status": float64(1)},
+		},
+		{
+			name:     "time fixed up",
+			ruleType: "precise_protection",
+			raw:      map[string]interface{}{"description": "night", "start": float64(1700000000000)},
+			expected: map[string]interface{}{
+				"description": "night", "start": float64(1700000000000), "time": true, "status": float64(1),
+			},
+		},
+		{
+			name:     "time not set",
+			ruleType: "precise_protection",
+			raw:      map[string]interface{}{"description": "always"},
+			expected: map[string]interface{}{"description": "always", "time": false, "status": float64(1)},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rule, err := canonicalBundleRule(bundleRuleTypes[c.ruleType], c.raw, false)
+			th.AssertNoErr(t, err)
+			th.AssertDeepEquals(t, c.expected, rule)
+		})
+	}
+
+	_, err := canonicalBundleRule(bundleRuleTypes["blacklist"], map[string]interface{}{"id": "rule-id"}, true)
+	if err == nil {
+		t.Fatal("expected error for unsupported field in strict mode")
+	}
+}
+
+func TestPlanBundleRules(t *testing.T) {
+	office := map[string]interface{}{"name": "office", "addr": "10.0.0.0/8", "white": float64(1), "status": float64(1)}
+	officeMoved := map[string]interface{}{"name": "office", "addr": "10.1.0.0/16", "white": float64(1), "status": float64(1)}
+	officeDisabled := map[string]interface{}{"name": "office", "addr": "10.0.0.0/8", "white": float64(1), "status": float64(0)}
+	attackers := map[string]interface{}{"name": "attackers", "addr": "192.168.0.1", "white": float64(0), "status": float64(1)}
+
+	admin := map[string]interface{}{"hostname": "www.example.com", "url": "/admin", "status": float64(1)}
+	adminDescribed := map[string]interface{}{"hostname": "www.example.com", "url": "/admin", "description": "admin", "status": float64(1)}
+
+	cases := []struct {
+		name     string
+		ruleType string
+		current  []bundleRule
+		desired  []map[string]interface{}
+		expected bundleRulePlan
+	}{
+		{
+			name:     "in sync",
+			ruleType: "blacklist",
+			current:  []bundleRule{{ID: "r1", Body: office}, {ID: "r2", Body: attackers}},
+			desired:  []map[string]interface{}{attackers, office},
+		},
+		{
+			name:     "created and deleted",
+			ruleType: "blacklist",
+			current:  []bundleRule{{ID: "r1", Body: office}},
+			desired:  []map[string]interface{}{attackers},
+			expected: bundleRulePlan{
+				create: []map[string]interface{}{attackers},
+				delete: []bundleRule{{ID: "r1", Body: office}},
+			},
+		},
+		{
+			name:     "updated by identity key",
+			ruleType: "blacklist",
+			current:  []bundleRule{{ID: "r1", Body: office}, {ID: "r2", Body: attackers}},
+			desired:  []map[string]interface{}{officeMoved, attackers},
+			expected: bundleRulePlan{
+				update: []bundleRule{{ID: "r1", Body: officeMoved}},
+			},
+		},
+		{
+			name:     "status updated",
+			ruleType: "blacklist",
+			current:  []bundleRule{{ID: "r1", Body: office}},
+			desired:  []map[string]interface{}{officeDisabled},
+			expected: bundleRulePlan{
+				update: []bundleRule{{ID: "r1", Body: officeDisabled}},
+			},
+		},
+		{
+			name:     "exact match preferred over identity key",
+			ruleType: "blacklist",
+			current:  []bundleRule{{ID: "r1", Body: officeMoved}, {ID: "r2", Body: office}},
+			desired:  []map[string]interface{}{office},
+			expected: bundleRulePlan{
+				delete: []bundleRule{{ID: "r1", Body: officeMoved}},
+			},
+		},
+		{
+			name:     "recreated without update",
+			ruleType: "web_tamper",
+			current:  []bundleRule{{ID: "r1", Body: admin}},
+			desired:  []map[string]interface{}{adminDescribed},
+			expected: bundleRulePlan{
+				create: []map[string]interface{}{adminDescribed},
+				delete: []bundleRule{{ID: "r1", Body: admin}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			plan := planBundleRules(bundleRuleTypes[c.ruleType], c.current, c.desired)
+			th.AssertDeepEquals(t, c.expected, plan)
+		})
+	}
+}
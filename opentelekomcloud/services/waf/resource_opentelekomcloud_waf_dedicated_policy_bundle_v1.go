@@ -0,0 +1,167 @@
+package waf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceWafDedicatedPolicyBundleV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWafDedicatedPolicyBundleV1Create,
+		ReadContext:   resourceWafDedicatedPolicyBundleV1Read,
+		UpdateContext: resourceWafDedicatedPolicyBundleV1Update,
+		DeleteContext: resourceWafDedicatedPolicyBundleV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validatePolicyBundle,
+				DiffSuppressFunc: suppressEquivalentPolicyBundle,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validatePolicyBundle(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parsePolicyBundle(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid WAF policy bundle: %s", k, err))
+	}
+	return
+}
+
+func suppressEquivalentPolicyBundle(_, old, new string, _ *schema.ResourceData) bool {
+	oldBundle, err := parsePolicyBundle(old)
+	if err != nil {
+		return false
+	}
+	newBundle, err := parsePolicyBundle(new)
+	if err != nil {
+		return false
+	}
+	oldDocument, err := renderPolicyBundle(oldBundle)
+	if err != nil {
+		return false
+	}
+	newDocument, err := renderPolicyBundle(newBundle)
+	if err != nil {
+		return false
+	}
+	return oldDocument == newDocument
+}
+
+func resourceWafDedicatedPolicyBundleV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.WafDedicatedV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	policyID := d.Get("policy_id").(string)
+	bundle, err := parsePolicyBundle(d.Get("document").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := applyPolicyBundle(client, policyID, bundle); err != nil {
+		return fmterr.Errorf("error applying OpenTelekomCloud WAF Dedicated policy bundle: %s", err)
+	}
+	d.SetId(policyID)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceWafDedicatedPolicyBundleV1Read(clientCtx, d, meta)
+}
+
+func resourceWafDedicatedPolicyBundleV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.WafDedicatedV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	bundle, _, err := readPolicyBundle(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "WAF Dedicated policy bundle")
+	}
+	// policy settings which are not managed by the document are not tracked
+	if configured, err := parsePolicyBundle(d.Get("document").(string)); err == nil && len(configured.Policy) == 0 {
+		bundle.Policy = nil
+	}
+	document, err := renderPolicyBundle(bundle)
+	if err != nil {
+		return fmterr.Errorf("error rendering OpenTelekomCloud WAF Dedicated policy bundle: %s", err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("policy_id", d.Id()),
+		d.Set("document", document),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting WAF Dedicated policy bundle fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceWafDedicatedPolicyBundleV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.WafDedicatedV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	if d.HasChange("document") {
+		bundle, err := parsePolicyBundle(d.Get("document").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := applyPolicyBundle(client, d.Id(), bundle); err != nil {
+			return fmterr.Errorf("error applying OpenTelekomCloud WAF Dedicated policy bundle: %s", err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceWafDedicatedPolicyBundleV1Read(clientCtx, d, meta)
+}
+
+func resourceWafDedicatedPolicyBundleV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.WafDedicatedV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	if err := deletePolicyBundleRules(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "WAF Dedicated policy bundle")
+	}
+	return nil
+}